@@ -10,6 +10,10 @@ const adapters: IVideoAdapter[] = [
 ];
 
 const EXT_PING_INTERVAL_MS = 3000;
+const REACTION_DURATION_MS = 6000;
+const REACTION_LANES = 8;
+
+let reactionLane = 0;
 
 let currentAdapter: IVideoAdapter = adapters[adapters.length - 1];
 let currentVideo: HTMLVideoElement | null = null;
@@ -62,6 +66,36 @@ async function applyState(state: ApplyState) {
   await currentAdapter.applyState(currentVideo, state);
 }
 
+// showReaction floats a reaction across the video, danmaku style.
+function showReaction(reaction: any) {
+  const body = [reaction?.emoji, reaction?.text].filter((part) => typeof part === "string" && part).join(" ");
+  if (!body) return;
+  const rect = currentVideo?.getBoundingClientRect();
+  if (!rect || rect.width === 0 || rect.height === 0) return;
+
+  const el = document.createElement("div");
+  el.textContent = body;
+  const laneHeight = Math.max(24, rect.height / (REACTION_LANES + 2));
+  Object.assign(el.style, {
+    position: "fixed",
+    left: `${rect.right}px`,
+    top: `${rect.top + laneHeight * (1 + reactionLane)}px`,
+    zIndex: "2147483647",
+    pointerEvents: "none",
+    whiteSpace: "nowrap",
+    font: "bold 20px sans-serif",
+    color: reaction?.self ? "#ffe066" : "#fff",
+    textShadow: "0 0 3px #000",
+    transition: `transform ${REACTION_DURATION_MS}ms linear`
+  });
+  reactionLane = (reactionLane + 1) % REACTION_LANES;
+  document.body.appendChild(el);
+  requestAnimationFrame(() => {
+    el.style.transform = `translateX(-${rect.width + el.offsetWidth}px)`;
+  });
+  setTimeout(() => el.remove(), REACTION_DURATION_MS);
+}
+
 function handleMessage(msg: any) {
   if (!msg || !msg.type) return;
   if (msg.type === "apply_state") {
@@ -75,6 +109,10 @@ function handleMessage(msg: any) {
     }
     return;
   }
+  if (msg.type === "reaction") {
+    showReaction(msg.payload);
+    return;
+  }
   if (msg.type === "notify") {
     const message = msg.payload?.message;
     if (typeof message === "string" && message.trim()) {
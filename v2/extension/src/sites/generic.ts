@@ -37,6 +37,8 @@ export function createGenericAdapter(siteName = "generic"): IVideoAdapter {
         duration_ms: Number.isFinite(video.duration) ? Math.floor(video.duration * 1000) : 0,
         paused: video.paused,
         rate: video.playbackRate || 1,
+        buffering: !video.ended && video.readyState < HTMLMediaElement.HAVE_FUTURE_DATA,
+        ended: video.ended,
         media: {
           url: location.href,
           title: document.title,
@@ -78,6 +80,10 @@ export function createGenericAdapter(siteName = "generic"): IVideoAdapter {
       video.addEventListener("pause", handler);
       video.addEventListener("ratechange", handler);
       video.addEventListener("seeking", handler);
+      video.addEventListener("waiting", handler);
+      video.addEventListener("stalled", handler);
+      video.addEventListener("canplay", handler);
+      video.addEventListener("ended", handler);
     }
   };
 }
\ No newline at end of file
@@ -10,6 +10,10 @@ export interface PlayerState {
   duration_ms: number;
   paused: boolean;
   rate: number;
+  // True while the player is waiting for data to continue.
+  buffering: boolean;
+  // True once playback reached the end of the media.
+  ended: boolean;
   media: MediaInfo;
 }
 
@@ -375,7 +375,7 @@ function sendToClient(msg: any) {
 
 function handleClientMessage(msg: any) {
   if (!msg || !msg.type) return;
-  if (msg.type === "apply_state" || msg.type === "navigate") {
+  if (msg.type === "apply_state" || msg.type === "navigate" || msg.type === "reaction") {
     forwardToTab(msg);
     return;
   }
@@ -384,7 +384,7 @@ function handleClientMessage(msg: any) {
     chrome.runtime.sendMessage(msg);
     return;
   }
-  if (msg.type === "room_event") {
+  if (msg.type === "room_event" || msg.type === "chat_message") {
     chrome.runtime.sendMessage(msg);
   }
 }
@@ -9,6 +9,12 @@ const roleBadge = document.getElementById("roleBadge") as HTMLSpanElement;
 const endpointBadge = document.getElementById("endpointBadge") as HTMLSpanElement;
 const joinCodeEl = document.getElementById("joinCode") as HTMLInputElement;
 const displayNameEl = document.getElementById("displayName") as HTMLInputElement;
+const joinPolicyEl = document.getElementById("joinPolicy") as HTMLSelectElement;
+const roomPasswordEl = document.getElementById("roomPassword") as HTMLInputElement;
+const awaitingNoteEl = document.getElementById("awaitingNote") as HTMLParagraphElement;
+const premiereStartEl = document.getElementById("premiereStart") as HTMLInputElement;
+const premiereUrlEl = document.getElementById("premiereUrl") as HTMLInputElement;
+const premiereTitleEl = document.getElementById("premiereTitle") as HTMLInputElement;
 const preRoomEl = document.getElementById("preRoom") as HTMLDivElement;
 const inRoomEl = document.getElementById("inRoom") as HTMLDivElement;
 const copyBtn = document.getElementById("copyBtn") as HTMLButtonElement;
@@ -20,6 +26,116 @@ const createBtn = document.getElementById("createBtn") as HTMLButtonElement;
 const joinBtn = document.getElementById("joinBtn") as HTMLButtonElement;
 const leaveBtn = document.getElementById("leaveBtn") as HTMLButtonElement;
 const clientPortRow = document.getElementById("clientPortRow") as HTMLDivElement;
+const membersPanel = document.getElementById("membersPanel") as HTMLElement;
+const membersListEl = document.getElementById("membersList") as HTMLDivElement;
+const mutedNoteEl = document.getElementById("mutedNote") as HTMLParagraphElement;
+const playbackPanel = document.getElementById("playbackPanel") as HTMLElement;
+const premiereNoteEl = document.getElementById("premiereNote") as HTMLParagraphElement;
+const groupHoldNoteEl = document.getElementById("groupHoldNote") as HTMLParagraphElement;
+const readyCheckBox = document.getElementById("readyCheckBox") as HTMLDivElement;
+const readyCheckNoteEl = document.getElementById("readyCheckNote") as HTMLParagraphElement;
+const readyAckBtn = document.getElementById("readyAckBtn") as HTMLButtonElement;
+const readyCheckBtn = document.getElementById("readyCheckBtn") as HTMLButtonElement;
+const roomSettingsPanel = document.getElementById("roomSettingsPanel") as HTMLElement;
+const pauseOnBufferingEl = document.getElementById("pauseOnBuffering") as HTMLInputElement;
+const controlModeEl = document.getElementById("controlMode") as HTMLSelectElement;
+const membersEditPlaylistEl = document.getElementById("membersEditPlaylist") as HTMLInputElement;
+const roomPublicEl = document.getElementById("roomPublic") as HTMLInputElement;
+const roomTitleEl = document.getElementById("roomTitle") as HTMLInputElement;
+const roomDescriptionEl = document.getElementById("roomDescription") as HTMLInputElement;
+const saveListingBtn = document.getElementById("saveListingBtn") as HTMLButtonElement;
+const directoryPanel = document.getElementById("directoryPanel") as HTMLElement;
+const directoryListEl = document.getElementById("directoryList") as HTMLDivElement;
+const directoryEmptyEl = document.getElementById("directoryEmpty") as HTMLParagraphElement;
+const listRoomsBtn = document.getElementById("listRoomsBtn") as HTMLButtonElement;
+const playlistPanel = document.getElementById("playlistPanel") as HTMLElement;
+const playlistCurrentEl = document.getElementById("playlistCurrent") as HTMLParagraphElement;
+const playlistListEl = document.getElementById("playlistList") as HTMLDivElement;
+const playlistAddRow = document.getElementById("playlistAddRow") as HTMLDivElement;
+const playlistUrlEl = document.getElementById("playlistUrl") as HTMLInputElement;
+const playlistAddBtn = document.getElementById("playlistAddBtn") as HTMLButtonElement;
+const controlPanel = document.getElementById("controlPanel") as HTMLElement;
+const controlListEl = document.getElementById("controlList") as HTMLDivElement;
+const syncPanel = document.getElementById("syncPanel") as HTMLElement;
+const syncListEl = document.getElementById("syncList") as HTMLDivElement;
+const chatPanel = document.getElementById("chatPanel") as HTMLElement;
+const chatListEl = document.getElementById("chatList") as HTMLDivElement;
+const chatInputEl = document.getElementById("chatInput") as HTMLInputElement;
+const chatSendBtn = document.getElementById("chatSendBtn") as HTMLButtonElement;
+const danmakuBtn = document.getElementById("danmakuBtn") as HTMLButtonElement;
+const reactionRow = document.getElementById("reactionRow") as HTMLDivElement;
+const pendingPanel = document.getElementById("pendingPanel") as HTMLElement;
+const pendingListEl = document.getElementById("pendingList") as HTMLDivElement;
+
+type UIMember = {
+  member_id: string;
+  display_name: string;
+  is_host: boolean;
+  muted?: boolean;
+  self?: boolean;
+};
+
+type UIMemberSync = {
+  member_id: string;
+  display_name: string;
+  drift_ms: number;
+  endpoint: string;
+  quality: string;
+};
+
+type UIReadyCheck = {
+  check_id: string;
+  ready: string[] | null;
+  waiting: string[] | null;
+  deadline_ms: number;
+  self_ready: boolean;
+};
+
+type UIChatMessage = {
+  message_id: string;
+  member_id: string;
+  display_name: string;
+  text: string;
+  server_time_ms: number;
+  self: boolean;
+};
+
+type UIPlaylistEntry = {
+  entry_id: string;
+  url: string;
+  title: string;
+  added_by_name: string;
+};
+
+type UIPlaylist = {
+  current?: UIPlaylistEntry;
+  entries: UIPlaylistEntry[] | null;
+  members_edit: boolean;
+};
+
+type UIPublicRoom = {
+  room_code: string;
+  title: string;
+  description: string;
+  host_name: string;
+  member_count: number;
+  media_title: string;
+  playing: boolean;
+  join_policy: string;
+};
+
+type UIControlRequest = {
+  member_id: string;
+  display_name: string;
+  action: string;
+  position_ms: number;
+};
+
+type UIPremiere = {
+  start_ms: number;
+  media_url: string;
+  media_title: string;
+};
 
 let localConnected = false;
 let serverConnected: boolean | null = null;
@@ -31,6 +147,12 @@ let currentClientPort = defaultClientPort;
 const roomEvents: string[] = [];
 const maxEvents = 6;
 const eventSuffixes = ["进入了房间", "离开了房间"];
+let readyCheck: UIReadyCheck | null = null;
+let premiere: UIPremiere | null = null;
+let chatMessages: UIChatMessage[] = [];
+let renderedChatKey = "";
+const maxChatMessages = 100;
+let directoryRequested = false;
 
 function scrollEventsToBottom() {
   window.requestAnimationFrame(() => {
@@ -48,7 +170,11 @@ function updateStatus() {
     return;
   }
   if (serverConnected === false) {
-    statusEl.textContent = "本地客户端已连接, 服务器未连接";
+    // The server holds our room slot for a while; the client reattaches
+    // with its resume token once it reconnects.
+    statusEl.textContent = currentRoomCode
+      ? "服务器连接中断, 正在重连 (房间保留中)"
+      : "本地客户端已连接, 服务器未连接";
     return;
   }
   statusEl.textContent = "本地客户端已连接";
@@ -65,6 +191,19 @@ function formatRole(value: unknown): string {
   }
 }
 
+function formatQuality(value: unknown): string {
+  switch (value) {
+    case "good":
+      return "良好";
+    case "fair":
+      return "一般";
+    case "poor":
+      return "较差";
+    default:
+      return "-";
+  }
+}
+
 function formatEndpoint(value: unknown): string {
   switch (value) {
     case "browser":
@@ -97,6 +236,48 @@ function localizeError(value: unknown): string {
   if (lower === "room not found") {
     return "房间不存在";
   }
+  if (lower === "wrong room password") {
+    return "房间密码错误";
+  }
+  if (lower === "password required") {
+    return "请输入房间密码";
+  }
+  if (lower === "join request rejected") {
+    return "房主拒绝了加入申请";
+  }
+  if (lower === "muted") {
+    return "你已被房主禁言";
+  }
+  if (lower === "message too long") {
+    return "消息过长 (最多 500 字)";
+  }
+  if (lower === "reaction too long") {
+    return "弹幕过长";
+  }
+  if (lower === "nothing is playing") {
+    return "当前没有播放的视频";
+  }
+  if (lower === "sending too fast") {
+    return "发送太快了";
+  }
+  if (lower === "not host") {
+    return "只有房主可以这样做";
+  }
+  if (lower === "media url is required") {
+    return "请填写视频链接";
+  }
+  if (lower === "media url must be http or https") {
+    return "只能添加 http 或 https 链接";
+  }
+  if (lower === "playlist is full") {
+    return "播放列表已满";
+  }
+  if (lower === "removed from the room by the host") {
+    return "你已被房主移出房间";
+  }
+  if (lower === "banned from this room by the host") {
+    return "你已被房主封禁";
+  }
   if (lower === "room closed (host left)") {
     return "房间已解散 (房主离开)";
   }
@@ -116,6 +297,362 @@ function sendAction(action: string, payload: Record<string, unknown> = {}) {
   });
 }
 
+function smallButton(label: string, onClick: () => void, secondary = true): HTMLButtonElement {
+  const button = document.createElement("button");
+  button.className = secondary ? "small secondary" : "small";
+  button.textContent = label;
+  button.addEventListener("click", onClick);
+  return button;
+}
+
+function listRow(label: string, ...actions: HTMLElement[]): HTMLDivElement {
+  const row = document.createElement("div");
+  row.className = "list-row";
+  const text = document.createElement("span");
+  text.textContent = label;
+  const buttons = document.createElement("span");
+  buttons.className = "row-actions";
+  buttons.append(...actions);
+  row.append(text, buttons);
+  return row;
+}
+
+function renderMembers(members: unknown, isHost: boolean) {
+  membersListEl.innerHTML = "";
+  if (!Array.isArray(members)) {
+    return;
+  }
+  for (const member of members as UIMember[]) {
+    let label = member.display_name || "成员";
+    if (member.is_host) {
+      label += " (房主)";
+    }
+    if (member.self) {
+      label += " (我)";
+    }
+    if (member.muted) {
+      label += " (已禁言)";
+    }
+    const actions: HTMLElement[] = [];
+    if (isHost && !member.self) {
+      const memberID = member.member_id;
+      actions.push(
+        smallButton("设为房主", () => sendAction("transfer_host", { member_id: memberID })),
+        smallButton(member.muted ? "解除禁言" : "禁言", () =>
+          sendAction("mute_member", { member_id: memberID, muted: !member.muted })
+        ),
+        smallButton("踢出", () => sendAction("kick_member", { member_id: memberID })),
+        smallButton("封禁", () => sendAction("ban_member", { member_id: memberID }))
+      );
+    }
+    membersListEl.appendChild(listRow(label, ...actions));
+  }
+}
+
+function renderSyncHealth(health: unknown) {
+  syncListEl.innerHTML = "";
+  const entries = Array.isArray(health) ? (health as UIMemberSync[]) : [];
+  syncPanel.hidden = entries.length === 0;
+  for (const entry of entries) {
+    const name = entry.display_name || "成员";
+    const drift = Math.round(entry.drift_ms || 0);
+    syncListEl.appendChild(
+      listRow(`${name} · ${formatEndpoint(entry.endpoint)} · 偏差 ${drift}ms · ${formatQuality(entry.quality)}`)
+    );
+  }
+}
+
+function renderGroupHold(state: Record<string, any>, inRoom: boolean) {
+  const waiting = Array.isArray(state.waiting_for) ? (state.waiting_for as string[]) : [];
+  groupHoldNoteEl.hidden = !inRoom || !state.group_hold;
+  groupHoldNoteEl.textContent = waiting.length > 0 ? `等待缓冲: ${waiting.join(", ")}` : "等待缓冲...";
+}
+
+function formatRemaining(targetMs: number): string {
+  let seconds = Math.max(0, Math.ceil((targetMs - Date.now()) / 1000));
+  const days = Math.floor(seconds / 86400);
+  seconds %= 86400;
+  const hours = Math.floor(seconds / 3600);
+  const minutes = Math.floor((seconds % 3600) / 60);
+  const secs = seconds % 60;
+  const pad = (value: number) => String(value).padStart(2, "0");
+  if (days > 0) {
+    return `${days}天 ${pad(hours)}:${pad(minutes)}:${pad(secs)}`;
+  }
+  if (hours > 0) {
+    return `${hours}:${pad(minutes)}:${pad(secs)}`;
+  }
+  if (minutes > 0) {
+    return `${minutes}:${pad(secs)}`;
+  }
+  return `${secs}秒`;
+}
+
+function renderReadyCheck() {
+  readyCheckBox.hidden = !readyCheck;
+  if (!readyCheck) {
+    return;
+  }
+  const ready = readyCheck.ready || [];
+  const waiting = readyCheck.waiting || [];
+  const parts = ["准备确认"];
+  if (ready.length > 0) {
+    parts.push(`已准备: ${ready.join(", ")}`);
+  }
+  if (waiting.length > 0) {
+    parts.push(`等待: ${waiting.join(", ")}`);
+  }
+  parts.push(`${formatRemaining(readyCheck.deadline_ms)}后开始`);
+  readyCheckNoteEl.textContent = parts.join(" · ");
+  readyAckBtn.textContent = readyCheck.self_ready ? "取消准备" : "我准备好了";
+}
+
+function renderPremiere() {
+  // Once the premiere starts the room plays normally.
+  const upcoming = premiere !== null && premiere.start_ms > Date.now();
+  premiereNoteEl.hidden = !upcoming;
+  if (!premiere || !upcoming) {
+    return;
+  }
+  const title = premiere.media_title || premiere.media_url;
+  premiereNoteEl.textContent = `首映 ${title} · ${formatRemaining(premiere.start_ms)}后开始`;
+}
+
+// renderCountdowns refreshes the countdowns; it runs every second.
+function renderCountdowns() {
+  renderReadyCheck();
+  const wasHidden = premiereNoteEl.hidden;
+  renderPremiere();
+  if (premiereNoteEl.hidden !== wasHidden) {
+    updatePlaybackPanel();
+  }
+}
+
+// updatePlaybackPanel hides the playback panel when it has nothing to show.
+function updatePlaybackPanel() {
+  playbackPanel.hidden = Array.from(playbackPanel.children).every((el) => (el as HTMLElement).hidden);
+}
+
+function formatPosition(ms: number): string {
+  const total = Math.max(0, Math.floor(ms / 1000));
+  const hours = Math.floor(total / 3600);
+  const minutes = Math.floor((total % 3600) / 60);
+  const seconds = String(total % 60).padStart(2, "0");
+  if (hours > 0) {
+    return `${hours}:${String(minutes).padStart(2, "0")}:${seconds}`;
+  }
+  return `${minutes}:${seconds}`;
+}
+
+function formatControlAction(request: UIControlRequest): string {
+  switch (request.action) {
+    case "play":
+      return "播放";
+    case "pause":
+      return "暂停";
+    case "seek":
+      return `跳转到 ${formatPosition(request.position_ms)}`;
+    default:
+      return request.action;
+  }
+}
+
+function renderControlRequests(requests: unknown) {
+  controlListEl.innerHTML = "";
+  const entries = Array.isArray(requests) ? (requests as UIControlRequest[]) : [];
+  controlPanel.hidden = entries.length === 0;
+  for (const request of entries) {
+    const memberID = request.member_id;
+    controlListEl.appendChild(
+      listRow(
+        `${request.display_name || "成员"}: ${formatControlAction(request)}`,
+        smallButton("同意", () => sendAction("answer_control", { member_id: memberID, approve: true }), false),
+        smallButton("拒绝", () => sendAction("answer_control", { member_id: memberID, approve: false }))
+      )
+    );
+  }
+}
+
+function renderChat() {
+  // ui_state repeats the whole chat; only redraw when it changed so the
+  // scroll position survives.
+  const key = chatMessages.map((message) => message.message_id).join(",");
+  if (key === renderedChatKey) {
+    return;
+  }
+  renderedChatKey = key;
+  chatListEl.innerHTML = "";
+  for (const message of chatMessages) {
+    const line = document.createElement("div");
+    const name = document.createElement("span");
+    name.className = "event-name";
+    name.textContent = message.self ? "我" : message.display_name || "成员";
+    const text = document.createElement("span");
+    text.className = "event-action";
+    text.textContent = `: ${message.text}`;
+    line.append(name, text);
+    chatListEl.appendChild(line);
+  }
+  window.requestAnimationFrame(() => {
+    chatListEl.scrollTop = chatListEl.scrollHeight;
+  });
+}
+
+function appendChat(message: UIChatMessage) {
+  if (!message || !message.message_id) {
+    return;
+  }
+  if (chatMessages.some((entry) => entry.message_id === message.message_id)) {
+    return;
+  }
+  chatMessages = [...chatMessages, message].slice(-maxChatMessages);
+  renderChat();
+}
+
+function sendChat() {
+  const text = chatInputEl.value.trim();
+  if (!text) {
+    return;
+  }
+  sendAction("send_chat", { text });
+  chatInputEl.value = "";
+}
+
+function renderPlaylist(playlist: UIPlaylist | undefined, isHost: boolean) {
+  playlistListEl.innerHTML = "";
+  const entries = playlist?.entries || [];
+  const canEdit = isHost || Boolean(playlist?.members_edit);
+  const current = playlist?.current;
+  playlistCurrentEl.hidden = !current;
+  playlistCurrentEl.textContent = current ? `正在播放: ${current.title || current.url}` : "";
+  playlistListEl.hidden = entries.length === 0;
+  playlistAddRow.hidden = !canEdit;
+  entries.forEach((entry, index) => {
+    const entryID = entry.entry_id;
+    const actions: HTMLElement[] = [];
+    if (isHost) {
+      actions.push(smallButton("播放", () => sendAction("playlist_play", { entry_id: entryID }), false));
+    }
+    if (canEdit) {
+      if (index > 0) {
+        actions.push(smallButton("↑", () => sendAction("playlist_move", { entry_id: entryID, index: index - 1 })));
+      }
+      if (index < entries.length - 1) {
+        actions.push(smallButton("↓", () => sendAction("playlist_move", { entry_id: entryID, index: index + 1 })));
+      }
+      actions.push(smallButton("删除", () => sendAction("playlist_remove", { entry_id: entryID })));
+    }
+    let label = `${index + 1}. ${entry.title || entry.url}`;
+    if (entry.added_by_name) {
+      label += ` · ${entry.added_by_name}`;
+    }
+    playlistListEl.appendChild(listRow(label, ...actions));
+  });
+}
+
+function joinPublicRoom(room: UIPublicRoom) {
+  const password = roomPasswordEl.value;
+  if (room.join_policy === "password" && !password) {
+    errorEl.textContent = "请输入房间密码";
+    roomPasswordEl.focus();
+    return;
+  }
+  sendAction("join_room", {
+    room_code: room.room_code,
+    display_name: displayNameEl.value.trim(),
+    password
+  });
+}
+
+function renderDirectory(rooms: unknown) {
+  directoryListEl.innerHTML = "";
+  const entries = Array.isArray(rooms) ? (rooms as UIPublicRoom[]) : [];
+  directoryEmptyEl.hidden = entries.length > 0;
+  for (const room of entries) {
+    let label = `${room.title || room.room_code} · ${room.host_name || "-"} · ${room.member_count}人`;
+    if (room.media_title) {
+      label += ` · ${room.playing ? "▶" : "⏸"} ${room.media_title}`;
+    }
+    if (room.join_policy === "password") {
+      label += " · 需要密码";
+    } else if (room.join_policy === "approval") {
+      label += " · 需房主同意";
+    }
+    const row = listRow(label, smallButton("加入", () => joinPublicRoom(room), false));
+    row.title = room.description || "";
+    directoryListEl.appendChild(row);
+  }
+}
+
+// setUnlessEditing updates input from ui_state unless the user is typing
+// in it.
+function setUnlessEditing(input: HTMLInputElement, value: unknown) {
+  if (document.activeElement !== input) {
+    input.value = typeof value === "string" ? value : "";
+  }
+}
+
+function renderPendingJoins(pending: unknown) {
+  pendingListEl.innerHTML = "";
+  const entries = Array.isArray(pending) ? (pending as UIMember[]) : [];
+  pendingPanel.hidden = entries.length === 0;
+  for (const member of entries) {
+    pendingListEl.appendChild(
+      listRow(
+        member.display_name || "成员",
+        smallButton("同意", () => sendAction("answer_join", { member_id: member.member_id, approve: true }), false),
+        smallButton("拒绝", () => sendAction("answer_join", { member_id: member.member_id, approve: false }))
+      )
+    );
+  }
+}
+
+// renderRoom draws the in-room panels from a ui_state payload.
+function renderRoom(state: Record<string, any>, inRoom: boolean) {
+  const isHost = state.role === "host";
+  membersPanel.hidden = !inRoom;
+  renderMembers(inRoom ? state.members : [], isHost);
+  mutedNoteEl.hidden = !inRoom || !state.muted;
+  chatPanel.hidden = !inRoom;
+  chatMessages = inRoom && Array.isArray(state.chat) ? (state.chat as UIChatMessage[]) : [];
+  renderChat();
+  chatInputEl.disabled = Boolean(state.muted);
+  chatSendBtn.disabled = Boolean(state.muted);
+  danmakuBtn.disabled = Boolean(state.muted);
+  reactionRow.querySelectorAll<HTMLButtonElement>("button").forEach((button) => {
+    button.disabled = Boolean(state.muted);
+  });
+  chatInputEl.placeholder = state.muted ? "你已被房主禁言" : "发送消息";
+  renderSyncHealth(inRoom && isHost ? state.sync_health : []);
+  roomSettingsPanel.hidden = !inRoom || !isHost;
+  pauseOnBufferingEl.checked = Boolean(state.pause_on_buffering);
+  controlModeEl.value = state.control_mode || "host_only";
+  renderControlRequests(inRoom && isHost ? state.control_requests : []);
+  membersEditPlaylistEl.checked = Boolean(state.playlist?.members_edit);
+  roomPublicEl.checked = Boolean(state.room_public);
+  setUnlessEditing(roomTitleEl, state.room_title);
+  setUnlessEditing(roomDescriptionEl, state.room_description);
+  directoryPanel.hidden = inRoom || !state.server_connected;
+  renderDirectory(inRoom ? [] : state.public_rooms);
+  if (inRoom) {
+    // Fetch a fresh directory after leaving.
+    directoryRequested = false;
+  } else if (state.server_connected && !directoryRequested) {
+    directoryRequested = true;
+    sendAction("list_rooms");
+  }
+  playlistPanel.hidden = !inRoom;
+  renderPlaylist(inRoom ? (state.playlist as UIPlaylist) : undefined, isHost);
+  renderGroupHold(state, inRoom);
+  readyCheck = inRoom && state.ready_check ? (state.ready_check as UIReadyCheck) : null;
+  premiere = inRoom && state.premiere ? (state.premiere as UIPremiere) : null;
+  readyCheckBtn.hidden = !inRoom || !isHost || readyCheck !== null;
+  renderCountdowns();
+  updatePlaybackPanel();
+  renderPendingJoins(inRoom && isHost ? state.pending_joins : []);
+  awaitingNoteEl.hidden = inRoom || !state.awaiting_approval;
+}
+
 function renderEvents() {
   eventsEl.innerHTML = "";
   for (const entry of roomEvents) {
@@ -196,16 +733,93 @@ function setClientPort(port: number) {
 
 createBtn.addEventListener("click", () => {
   const name = displayNameEl.value.trim();
-  sendAction("create_room", { display_name: name });
+  const joinPolicy = joinPolicyEl.value;
+  const password = roomPasswordEl.value;
+  if (joinPolicy === "password" && !password) {
+    errorEl.textContent = "请输入房间密码";
+    roomPasswordEl.focus();
+    return;
+  }
+  const premiereUrl = premiereUrlEl.value.trim();
+  const premiereStartMs = premiereStartEl.value ? new Date(premiereStartEl.value).getTime() : 0;
+  if (premiereUrl && !(premiereStartMs > Date.now())) {
+    errorEl.textContent = "请填写未来的首映开始时间";
+    premiereStartEl.focus();
+    return;
+  }
+  if (premiereStartMs && !premiereUrl) {
+    errorEl.textContent = "请填写首映视频链接";
+    premiereUrlEl.focus();
+    return;
+  }
+  sendAction("create_room", {
+    display_name: name,
+    join_policy: joinPolicy,
+    password: joinPolicy === "password" ? password : "",
+    premiere_start_ms: premiereStartMs,
+    premiere_url: premiereUrl,
+    premiere_title: premiereTitleEl.value.trim()
+  });
 });
 joinBtn.addEventListener("click", () => {
   const code = joinCodeEl.value.trim();
   const name = displayNameEl.value.trim();
   if (code) {
-    sendAction("join_room", { room_code: code, display_name: name });
+    sendAction("join_room", { room_code: code, display_name: name, password: roomPasswordEl.value });
   }
 });
 leaveBtn.addEventListener("click", () => sendAction("leave_room"));
+chatSendBtn.addEventListener("click", sendChat);
+danmakuBtn.addEventListener("click", () => {
+  const text = chatInputEl.value.trim();
+  if (text) {
+    sendAction("send_reaction", { text });
+    chatInputEl.value = "";
+  }
+});
+reactionRow.querySelectorAll<HTMLButtonElement>("button").forEach((button) => {
+  button.addEventListener("click", () => sendAction("send_reaction", { emoji: button.dataset.emoji || "" }));
+});
+chatInputEl.addEventListener("keydown", (event) => {
+  if (event.key === "Enter") {
+    sendChat();
+  }
+});
+readyCheckBtn.addEventListener("click", () => sendAction("start_ready_check"));
+readyAckBtn.addEventListener("click", () => {
+  if (readyCheck) {
+    sendAction("ready_check_ack", { ready: !readyCheck.self_ready });
+  }
+});
+pauseOnBufferingEl.addEventListener("change", () => {
+  sendAction("update_room_settings", { pause_on_buffering: pauseOnBufferingEl.checked });
+});
+membersEditPlaylistEl.addEventListener("change", () => {
+  sendAction("update_room_settings", { members_edit_playlist: membersEditPlaylistEl.checked });
+});
+playlistAddBtn.addEventListener("click", () => {
+  // An empty URL queues the page the local player has open.
+  sendAction("playlist_add", { media_url: playlistUrlEl.value.trim() });
+  playlistUrlEl.value = "";
+});
+playlistUrlEl.addEventListener("keydown", (event) => {
+  if (event.key === "Enter") {
+    playlistAddBtn.click();
+  }
+});
+roomPublicEl.addEventListener("change", () => {
+  sendAction("update_room_settings", { public: roomPublicEl.checked });
+});
+saveListingBtn.addEventListener("click", () => {
+  sendAction("update_room_settings", {
+    title: roomTitleEl.value.trim(),
+    description: roomDescriptionEl.value.trim()
+  });
+});
+listRoomsBtn.addEventListener("click", () => sendAction("list_rooms"));
+controlModeEl.addEventListener("change", () => {
+  sendAction("update_room_settings", { control_mode: controlModeEl.value });
+});
 copyBtn.addEventListener("click", () => {
   if (currentRoomCode) {
     navigator.clipboard.writeText(currentRoomCode).catch(() => {
@@ -281,6 +895,7 @@ chrome.runtime.onMessage.addListener((msg) => {
       roomLabelPrefixEl.textContent = "房间号: ";
       roomCodeEl.textContent = "-";
       membersEl.textContent = "-";
+      renderRoom({}, false);
     }
     updateStatus();
     return;
@@ -289,14 +904,18 @@ chrome.runtime.onMessage.addListener((msg) => {
     appendEvent(String(msg.payload?.message || ""));
     return;
   }
+  if (msg.type === "chat_message") {
+    appendChat(msg.payload as UIChatMessage);
+    return;
+  }
   if (msg.type !== "ui_state") return;
   const state = msg.payload || {};
   localConnected = true;
   serverConnected = typeof state.server_connected === "boolean" ? state.server_connected : null;
-  updateStatus();
-
   const code = state.room_code || "";
   currentRoomCode = code;
+  updateStatus();
+
   const displayName = state.display_name || "";
   const hostDisplayName = state.host_display_name || "";
   if (!displayNameEl.value && displayName) {
@@ -325,6 +944,7 @@ chrome.runtime.onMessage.addListener((msg) => {
   inRoomEl.hidden = !inRoom;
   createBtn.disabled = inRoom;
   joinBtn.disabled = inRoom;
+  renderRoom(state, inRoom);
   if (!inRoom) {
     if (copyTimeout) {
       window.clearTimeout(copyTimeout);
@@ -364,4 +984,5 @@ inRoomEl.hidden = true;
 createBtn.disabled = false;
 joinBtn.disabled = false;
 updateStatus();
+window.setInterval(renderCountdowns, 1000);
 sendAction("refresh_state");
@@ -2,18 +2,20 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"log"
 	"math"
 	"net/url"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/gorilla/websocket"
-	"google.golang.org/protobuf/proto"
-
 	"videowithyou/v2/local-client/internal/adapter"
 	"videowithyou/v2/local-client/internal/bridge"
 	"videowithyou/v2/local-client/internal/config"
@@ -31,7 +33,54 @@ type timeSyncSample struct {
 	t4 int64
 }
 
-const maxRoomEvents = 20
+const (
+	maxRoomEvents = 20
+	// followerReportInterval is how often a follower reports its drift.
+	followerReportInterval = 2 * time.Second
+	// bufferingReportDelay keeps short stalls (a seek, a quality switch)
+	// from holding the whole room.
+	bufferingReportDelay = time.Second
+	// premiereLead is how long before a premiere the local player is paused
+	// at the start position.
+	premiereLead = 2 * time.Second
+	// controlGrace is how long a follower in a shared-control room leaves
+	// its own change alone after passing it on, until the host's state
+	// reflects it.
+	controlGrace = 2 * time.Second
+	// maxChatMessages bounds the chat kept for the popup.
+	maxChatMessages = 100
+	// reactionCatchUp is the most playback may advance between ticks for
+	// the reactions passed over to be shown; a larger jump is a seek. It
+	// also bounds how far behind a live reaction may land and still show.
+	reactionCatchUp = 5 * time.Second
+)
+
+// protocolVersion is the protocol this client speaks.
+const protocolVersion = 2
+
+// Capabilities offered in ClientHello. The server answers with the ones it
+// supports too; messages for the others are not sent.
+const (
+	capResume          = "resume"
+	capChat            = "chat"
+	capReactions       = "reactions"
+	capPlaylist        = "playlist"
+	capDirectory       = "directory"
+	capFollowerReports = "follower_reports"
+	capGroupPlayback   = "group_playback"
+	capSharedControl   = "shared_control"
+)
+
+var capabilities = []string{
+	capResume,
+	capChat,
+	capReactions,
+	capPlaylist,
+	capDirectory,
+	capFollowerReports,
+	capGroupPlayback,
+	capSharedControl,
+}
 
 type Client struct {
 	log     *log.Logger
@@ -43,8 +92,11 @@ type Client struct {
 	adapter  adapter.Endpoint
 	syncer   *syncer.Core
 
-	offsetMs atomic.Int64
-	tickMs   atomic.Int64
+	offsetMs   atomic.Int64
+	tickMs     atomic.Int64
+	requestSeq atomic.Uint64
+	// resyncing is set while a time sync requested by the server runs.
+	resyncing atomic.Bool
 
 	mu                     sync.Mutex
 	role                   Role
@@ -54,9 +106,12 @@ type Client struct {
 	roomCode               string
 	hostID                 string
 	clientID               string
+	resumeToken            string
 	membersCount           int
 	lastError              string
+	serverNotice           string
 	lastHostState          *videowithyoupb.HostState
+	hostSeq                uint64
 	lastHostURL            string
 	lastNavigateURL        string
 	lastNavigateAt         time.Time
@@ -74,6 +129,68 @@ type Client struct {
 	members                map[string]string
 	serverConnected        bool
 	pendingRoomAction      bool
+	pendingRequestID       string
+	desiredPassword        string
+	desiredJoinPolicy      videowithyoupb.JoinPolicy
+	joinPolicy             videowithyoupb.JoinPolicy
+	pendingJoins           map[string]string
+	mutedMembers           map[string]bool
+	awaitingApproval       bool
+
+	// Follower side: last correction applied and when the last report went out.
+	lastSyncAction   syncer.Action
+	lastSyncActionAt time.Time
+	lastReportAt     time.Time
+	// Host side: latest SyncHealth summary from the server.
+	syncHealth []UIMemberSync
+
+	// bufferingSince is when the local player started stalling;
+	// reportedBuffering is what the server was last told.
+	bufferingSince    time.Time
+	reportedBuffering bool
+	pauseOnBuffering  bool
+	// groupHold is set from a GroupPlayback hold until its release is due;
+	// groupGen invalidates a scheduled release superseded by a newer one.
+	groupHold    bool
+	groupWaiting []string
+	groupGen     uint64
+	// readyCheck is the ready check in progress, as shown in the popup.
+	readyCheck *UIReadyCheck
+	// desiredPremiere is sent with CreateRoomReq; premiere is the room's
+	// scheduled start and premiereStartMs the one already scheduled locally.
+	desiredPremiere *videowithyoupb.Premiere
+	premiere        *UIPremiere
+	premiereStartMs int64
+	// controlMode is the room's control mode. Followers skip Align until
+	// controlGraceUntil after sending a ControlIntent; the host keeps
+	// intents awaiting approval in controlRequests, keyed by member id.
+	controlMode       videowithyoupb.ControlMode
+	controlGraceUntil time.Time
+	controlRequests   map[string]*videowithyoupb.ControlIntent
+	chat              []UIChatMessage
+	// reactions are the current media's reactions ordered by position.
+	// reactionCursor is the host-timeline position reactions have been
+	// shown up to.
+	reactions         []*videowithyoupb.Reaction
+	reactionMedia     string
+	reactionCursor    int64
+	reactionCursorSet bool
+	// playlist is the room's shared queue as of the last snapshot;
+	// playlistSeen is set once one arrived. playlistAdvanced is set once the
+	// host asked to move past the current entry.
+	playlist            *videowithyoupb.Playlist
+	playlistSeen        bool
+	playlistAdvanced    bool
+	membersEditPlaylist bool
+	// roomPublic, roomTitle and roomDescription are the room's directory
+	// listing; publicRooms is the directory as last fetched.
+	roomPublic      bool
+	roomTitle       string
+	roomDescription string
+	publicRooms     []UIPublicRoom
+	// serverCaps is what the last ServerHello negotiated; nil until the
+	// first one arrives.
+	serverCaps map[string]bool
 
 	timeSyncCh chan timeSyncSample
 }
@@ -88,6 +205,12 @@ func New(cfg config.Config, cfgPath string, host bridge.Host, logger *log.Logger
 		endpointAdapter = adapter.NewMPCAdapter(cfg.MPC, logger)
 	}
 	syncCfg := syncConfigForEndpoint(cfg, cfg.Endpoint)
+	if strings.TrimSpace(cfg.ClientIdentity) == "" {
+		cfg.ClientIdentity = newClientIdentity()
+		if err := config.SaveConfig(cfgPath, cfg); err != nil {
+			logger.Printf("save client identity failed: %v", err)
+		}
+	}
 
 	client := &Client{
 		log:        logger,
@@ -98,17 +221,13 @@ func New(cfg config.Config, cfgPath string, host bridge.Host, logger *log.Logger
 		adapter:    endpointAdapter,
 		syncer:     syncer.NewCore(syncCfg, endpointAdapter, logger),
 		timeSyncCh: make(chan timeSyncSample, 8),
+		// The token from before a restart lets the server hand back the
+		// room slot it is still holding.
+		resumeToken: cfg.ResumeToken,
 	}
 	client.tickMs.Store(cfg.TickMS)
 
-	client.wsClient.SetOnConnect(func(conn *websocket.Conn) error {
-		hello := client.makeClientHello()
-		payload, err := proto.Marshal(hello)
-		if err != nil {
-			return err
-		}
-		return conn.WriteMessage(websocket.BinaryMessage, payload)
-	})
+	client.wsClient.SetHello(client.makeClientHello)
 
 	client.wsClient.SetOnStatus(func(connected bool) {
 		client.mu.Lock()
@@ -122,15 +241,23 @@ func New(cfg config.Config, cfgPath string, host bridge.Host, logger *log.Logger
 }
 
 func (c *Client) makeClientHello() *videowithyoupb.Envelope {
+	c.mu.Lock()
 	displayName := strings.TrimSpace(c.cfg.DisplayName)
+	resumeToken := c.resumeToken
+	identity := c.cfg.ClientIdentity
+	c.mu.Unlock()
 	if displayName == "" {
 		displayName = "local-client"
 	}
 	return &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_ClientHello{
 			ClientHello: &videowithyoupb.ClientHello{
-				ClientName:    displayName,
-				ClientVersion: "v2",
+				ClientName:      displayName,
+				ClientVersion:   "v2",
+				ResumeToken:     resumeToken,
+				ClientIdentity:  identity,
+				ProtocolVersion: protocolVersion,
+				Capabilities:    capabilities,
 			},
 		},
 	}
@@ -167,6 +294,8 @@ func (c *Client) handleEnvelope(env *videowithyoupb.Envelope) {
 		c.handleServerHello(payload.ServerHello)
 	case *videowithyoupb.Envelope_CreateRoomResp:
 		c.handleCreateRoomResp(payload.CreateRoomResp)
+	case *videowithyoupb.Envelope_ListRoomsResp:
+		c.handleListRoomsResp(payload.ListRoomsResp)
 	case *videowithyoupb.Envelope_JoinRoomResp:
 		c.handleJoinRoomResp(payload.JoinRoomResp)
 	case *videowithyoupb.Envelope_RoomSnapshot:
@@ -177,6 +306,28 @@ func (c *Client) handleEnvelope(env *videowithyoupb.Envelope) {
 		c.handleTimeSyncResp(payload.TimeSyncResp)
 	case *videowithyoupb.Envelope_ErrorResp:
 		c.handleError(payload.ErrorResp)
+	case *videowithyoupb.Envelope_JoinPendingResp:
+		c.handleJoinPending(payload.JoinPendingResp)
+	case *videowithyoupb.Envelope_JoinRequestNotice:
+		c.handleJoinRequestNotice(payload.JoinRequestNotice)
+	case *videowithyoupb.Envelope_ServerNotice:
+		c.handleServerNotice(payload.ServerNotice)
+	case *videowithyoupb.Envelope_SyncHealth:
+		c.handleSyncHealth(payload.SyncHealth)
+	case *videowithyoupb.Envelope_GroupPlayback:
+		c.handleGroupPlayback(payload.GroupPlayback)
+	case *videowithyoupb.Envelope_ReadyCheck:
+		c.handleReadyCheck(payload.ReadyCheck)
+	case *videowithyoupb.Envelope_ControlIntent:
+		c.handleControlIntent(payload.ControlIntent)
+	case *videowithyoupb.Envelope_ControlDecision:
+		c.handleControlDecision(payload.ControlDecision)
+	case *videowithyoupb.Envelope_ChatMessage:
+		c.handleChatMessage(payload.ChatMessage)
+	case *videowithyoupb.Envelope_Reaction:
+		c.handleReaction(payload.Reaction)
+	case *videowithyoupb.Envelope_ReactionBatch:
+		c.handleReactionBatch(payload.ReactionBatch)
 	}
 }
 
@@ -186,11 +337,34 @@ func (c *Client) handleServerHello(msg *videowithyoupb.ServerHello) {
 	}
 	c.mu.Lock()
 	c.clientID = msg.ClientId
+	c.resumeToken = msg.ResumeToken
+	// Servers that predate negotiation send no capabilities and support
+	// none of them.
+	c.serverCaps = make(map[string]bool, len(msg.Capabilities))
+	for _, name := range msg.Capabilities {
+		c.serverCaps[name] = true
+	}
+	saveToken := c.cfg.ResumeToken != msg.ResumeToken
+	c.cfg.ResumeToken = msg.ResumeToken
+	cfg := c.cfg
 	c.mu.Unlock()
 
-	c.log.Printf("server hello client_id=%s", msg.ClientId)
+	if saveToken {
+		if err := config.SaveConfig(c.cfgPath, cfg); err != nil {
+			c.log.Printf("save resume token failed: %v", err)
+		}
+	}
+
+	c.log.Printf("server hello client_id=%s resumed=%t protocol=%d capabilities=%v", msg.ClientId, msg.Resumed, msg.ProtocolVersion, msg.Capabilities)
 	go c.runInitialTimeSync()
 
+	if msg.Resumed {
+		// The server kept our room slot; the RoomSnapshot that follows
+		// restores room, code and role.
+		c.sendUIState()
+		return
+	}
+
 	c.mu.Lock()
 	desiredRole := c.desiredRole
 	desiredRoom := c.desiredRoom
@@ -203,6 +377,27 @@ func (c *Client) handleServerHello(msg *videowithyoupb.ServerHello) {
 	}
 }
 
+// serverSupportsLocked reports whether the server negotiated capability. It
+// is called with c.mu held.
+func (c *Client) serverSupportsLocked(capability string) bool {
+	return c.serverCaps == nil || c.serverCaps[capability]
+}
+
+// requireServerCapability reports whether the server negotiated
+// capability, and shows the popup an error if not.
+func (c *Client) requireServerCapability(capability string) bool {
+	c.mu.Lock()
+	supported := c.serverSupportsLocked(capability)
+	if !supported {
+		c.lastError = "server does not support " + capability
+	}
+	c.mu.Unlock()
+	if !supported {
+		c.sendUIState()
+	}
+	return supported
+}
+
 func (c *Client) handleCreateRoomResp(resp *videowithyoupb.CreateRoomResp) {
 	if resp == nil {
 		return
@@ -227,6 +422,9 @@ func (c *Client) handleCreateRoomResp(resp *videowithyoupb.CreateRoomResp) {
 	c.members = nil
 	c.lastError = ""
 	c.pendingRoomAction = false
+	c.pendingRequestID = ""
+	c.pendingJoins = nil
+	c.desiredPremiere = nil
 	c.mu.Unlock()
 
 	c.log.Printf("room created code=%s", resp.RoomCode)
@@ -258,6 +456,13 @@ func (c *Client) handleJoinRoomResp(resp *videowithyoupb.JoinRoomResp) {
 	c.members = nil
 	c.lastError = ""
 	c.pendingRoomAction = false
+	c.pendingRequestID = ""
+	c.pendingJoins = nil
+	c.awaitingApproval = false
+	c.chat = nil
+	for _, msg := range resp.RecentChat {
+		c.appendChatLocked(msg)
+	}
 	c.mu.Unlock()
 
 	c.log.Printf("room joined id=%s host=%s", resp.RoomId, resp.HostId)
@@ -272,6 +477,7 @@ func (c *Client) handleRoomSnapshot(snapshot *videowithyoupb.RoomSnapshot) {
 		return
 	}
 	c.mu.Lock()
+	previousHostID := c.hostID
 	c.roomID = snapshot.RoomId
 	c.roomCode = snapshot.RoomCode
 	c.hostID = snapshot.HostId
@@ -282,8 +488,56 @@ func (c *Client) handleRoomSnapshot(snapshot *videowithyoupb.RoomSnapshot) {
 		c.lastHostState = snapshot.LatestState
 	}
 	c.pendingRoomAction = false
+	c.pendingRequestID = ""
+	c.joinPolicy = snapshot.GetSettings().GetJoinPolicy()
+	c.pauseOnBuffering = snapshot.GetSettings().GetPauseOnBuffering()
+	c.controlMode = snapshot.GetSettings().GetControlMode()
+	c.membersEditPlaylist = snapshot.GetSettings().GetMembersEditPlaylist()
+	c.roomPublic = snapshot.GetSettings().GetPublic()
+	c.roomTitle = snapshot.GetSettings().GetTitle()
+	c.roomDescription = snapshot.GetSettings().GetDescription()
+	if c.controlMode != videowithyoupb.ControlMode_CONTROL_MODE_REQUEST || c.hostID != c.clientID {
+		c.controlRequests = nil
+	}
+	c.mutedMembers = make(map[string]bool)
+	for _, member := range snapshot.Members {
+		if member != nil && member.Muted {
+			c.mutedMembers[member.MemberId] = true
+		}
+	}
+	if previousHostID != "" && previousHostID != snapshot.HostId {
+		events = append(events, formatHostEvent(c.hostDisplayName))
+	}
+	role, switched := c.applyHostChangeLocked()
+	hostState := c.lastHostState
+	premiereGen := c.applyPremiereLocked(snapshot.Premiere)
+	playlistURL := c.applyPlaylistLocked(snapshot.Playlist)
+	if playlistURL != "" {
+		events = append(events, formatPlaylistEvent(snapshot.Playlist.Current.GetMedia()))
+	}
+	endpoint := c.cfg.Endpoint
+	adapter := c.adapter
+	lastExtURL := c.lastExtURL
 	c.mu.Unlock()
 
+	if playlistURL != "" && endpoint == "browser" && adapter != nil {
+		c.navigatePlaylist(role, adapter, playlistURL, lastExtURL)
+	}
+
+	if premiereGen != 0 {
+		c.schedulePremiere(premiereGen, snapshot.Premiere, role)
+	}
+
+	if switched {
+		c.log.Printf("room role changed role=%s host=%s", role, snapshot.HostId)
+		if role == RoleHost {
+			rate := 1.0
+			if hostState != nil && hostState.Rate > 0 {
+				rate = hostState.Rate
+			}
+			c.syncer.Reset(rate)
+		}
+	}
 	c.recordRoomEvents(events)
 	c.sendRoomEvents(events)
 	c.sendUIState()
@@ -294,6 +548,11 @@ func (c *Client) handleBroadcastState(state *videowithyoupb.BroadcastState) {
 		return
 	}
 	c.mu.Lock()
+	if c.role == RoleHost {
+		// Stale broadcasts can still arrive right after a promotion.
+		c.mu.Unlock()
+		return
+	}
 	if state.State != nil && state.State.HostId != "" {
 		c.hostID = state.State.HostId
 	}
@@ -357,16 +616,119 @@ func (c *Client) handleError(errResp *videowithyoupb.ErrorResp) {
 		return
 	}
 	message := errResp.Message
+	code := errResp.Code
+	if code == videowithyoupb.ErrorCode_ERROR_CODE_CLOCK_SKEW {
+		c.log.Printf("server reports clock skew, resyncing: %s", message)
+		c.resyncTime()
+		return
+	}
 	c.mu.Lock()
 	c.lastError = message
-	c.pendingRoomAction = false
-	isRoomClosed := strings.Contains(strings.ToLower(message), "room closed")
-	if isRoomClosed {
+	// Servers without request ids fail whatever is pending.
+	failedPending := errResp.RequestId == "" || errResp.RequestId == c.pendingRequestID
+	if failedPending {
+		c.pendingRoomAction = false
+		c.pendingRequestID = ""
+	}
+	switch code {
+	case videowithyoupb.ErrorCode_ERROR_CODE_ROOM_CLOSED_HOST_LEFT,
+		videowithyoupb.ErrorCode_ERROR_CODE_ROOM_CLOSED_IDLE,
+		videowithyoupb.ErrorCode_ERROR_CODE_ROOM_CLOSED_ADMIN:
 		c.clearRoomLocked()
 		c.lastError = message
+	case videowithyoupb.ErrorCode_ERROR_CODE_KICKED:
+		c.clearRoomLocked()
+		c.lastError = "removed from the room by the host"
+	case videowithyoupb.ErrorCode_ERROR_CODE_BANNED:
+		c.clearRoomLocked()
+		c.lastError = "banned from this room by the host"
+	case videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND,
+		videowithyoupb.ErrorCode_ERROR_CODE_WRONG_PASSWORD,
+		videowithyoupb.ErrorCode_ERROR_CODE_JOIN_REJECTED:
+		if code == videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND && c.roomID != "" {
+			// Joins are refused while in a room, so this is the room
+			// going away, e.g. with the server instance hosting it.
+			c.clearRoomLocked()
+			c.lastError = message
+		} else if failedPending && c.roomID == "" {
+			// Do not retry the join on the next reconnect.
+			c.desiredRole = RoleNone
+			c.desiredRoom = ""
+			c.desiredPassword = ""
+			c.awaitingApproval = false
+		}
+	case videowithyoupb.ErrorCode_ERROR_CODE_RATE_LIMITED:
+		// A rate-limited join stays wanted; it is retried on the next
+		// reconnect or by the user.
+		c.lastError = "rate limited by the server: " + message
+	case videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED:
+		if strings.Contains(strings.ToLower(message), "room closed") {
+			c.clearRoomLocked()
+			c.lastError = message
+		}
+	}
+	c.mu.Unlock()
+	c.log.Printf("server error: code=%s request=%s %s", code, errResp.RequestId, message)
+	c.sendUIState()
+}
+
+func (c *Client) handleJoinPending(resp *videowithyoupb.JoinPendingResp) {
+	if resp == nil {
+		return
+	}
+	c.mu.Lock()
+	if resp.RequestId != "" && resp.RequestId != c.pendingRequestID {
+		c.mu.Unlock()
+		return
+	}
+	c.awaitingApproval = true
+	c.lastError = ""
+	c.mu.Unlock()
+
+	c.log.Printf("join pending approval room=%s", resp.RoomId)
+	c.sendUIState()
+}
+
+func (c *Client) handleJoinRequestNotice(notice *videowithyoupb.JoinRequestNotice) {
+	if notice == nil || notice.MemberId == "" {
+		return
+	}
+	var events []string
+	c.mu.Lock()
+	if notice.Withdrawn {
+		delete(c.pendingJoins, notice.MemberId)
+	} else {
+		if c.pendingJoins == nil {
+			c.pendingJoins = make(map[string]string)
+		}
+		if _, ok := c.pendingJoins[notice.MemberId]; !ok {
+			events = append(events, formatKnockEvent(notice.DisplayName))
+		}
+		c.pendingJoins[notice.MemberId] = strings.TrimSpace(notice.DisplayName)
 	}
 	c.mu.Unlock()
-	c.log.Printf("server error: %s", message)
+
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+	c.sendUIState()
+}
+
+func (c *Client) handleServerNotice(notice *videowithyoupb.ServerNotice) {
+	if notice == nil {
+		return
+	}
+	message := strings.TrimSpace(notice.Message)
+	if message == "" {
+		return
+	}
+	c.mu.Lock()
+	c.serverNotice = message
+	c.mu.Unlock()
+
+	c.log.Printf("server notice: %s", message)
+	events := []string{formatNoticeEvent(message)}
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
 	c.sendUIState()
 }
 
@@ -485,6 +847,15 @@ func (c *Client) handleUIAction(payload []byte, raw []byte) {
 		c.pendingRoomAction = true
 		c.desiredRole = RoleHost
 		c.desiredRoom = ""
+		c.desiredPassword = action.Password
+		c.desiredJoinPolicy = parseJoinPolicy(action.JoinPolicy)
+		c.desiredPremiere = nil
+		if url := strings.TrimSpace(action.PremiereURL); url != "" {
+			c.desiredPremiere = &videowithyoupb.Premiere{
+				StartServerTimeMs: action.PremiereStartMs + c.offsetMs.Load(),
+				Media:             &videowithyoupb.MediaInfo{Url: url, Title: strings.TrimSpace(action.PremiereTitle)},
+			}
+		}
 		c.mu.Unlock()
 		if saveConfig {
 			_ = config.SaveConfig(c.cfgPath, cfg)
@@ -523,6 +894,7 @@ func (c *Client) handleUIAction(payload []byte, raw []byte) {
 		c.pendingRoomAction = true
 		c.desiredRole = RoleFollower
 		c.desiredRoom = action.RoomCode
+		c.desiredPassword = action.Password
 		c.mu.Unlock()
 		if saveConfig {
 			_ = config.SaveConfig(c.cfgPath, cfg)
@@ -530,7 +902,21 @@ func (c *Client) handleUIAction(payload []byte, raw []byte) {
 		c.sendClientHello()
 		c.sendJoinRoom(action.RoomCode)
 	case "leave_room":
-		c.sendLeaveRoom()
+		c.sendLeaveRoom(action.MemberID)
+	case "transfer_host":
+		c.sendTransferHost(action.MemberID)
+	case "kick_member", "ban_member":
+		c.sendModeration(action.Action, action.MemberID, false)
+	case "mute_member":
+		muted := true
+		if action.Muted != nil {
+			muted = *action.Muted
+		}
+		c.sendModeration(action.Action, action.MemberID, muted)
+	case "answer_join":
+		if action.Approve != nil {
+			c.sendJoinDecision(action.MemberID, *action.Approve)
+		}
 	case "set_endpoint":
 		if action.Endpoint != "" {
 			c.updateEndpoint(action.Endpoint)
@@ -543,6 +929,34 @@ func (c *Client) handleUIAction(payload []byte, raw []byte) {
 		if action.Config != nil {
 			c.applyConfig(*action.Config)
 		}
+	case "start_ready_check":
+		c.sendReadyCheck(action.TimeoutSec)
+	case "ready_check_ack":
+		ready := true
+		if action.Ready != nil {
+			ready = *action.Ready
+		}
+		c.sendReadyCheckAck(ready)
+	case "update_room_settings":
+		c.sendUpdateRoomSettings(action)
+	case "list_rooms":
+		c.sendListRooms()
+	case "answer_control":
+		if action.Approve != nil {
+			c.answerControlRequest(action.MemberID, *action.Approve)
+		}
+	case "send_chat":
+		c.sendChat(action.Text)
+	case "send_reaction":
+		c.sendReaction(action.Emoji, action.Text)
+	case "playlist_add":
+		c.sendPlaylistAdd(action.MediaURL, action.MediaTitle)
+	case "playlist_remove":
+		c.sendPlaylistUpdate(videowithyoupb.PlaylistOp_PLAYLIST_OP_REMOVE, action.EntryID, 0)
+	case "playlist_move":
+		c.sendPlaylistUpdate(videowithyoupb.PlaylistOp_PLAYLIST_OP_MOVE, action.EntryID, action.Index)
+	case "playlist_play":
+		c.sendPlaylistUpdate(videowithyoupb.PlaylistOp_PLAYLIST_OP_PLAY, action.EntryID, 0)
 	case "refresh_state":
 		c.sendUIState()
 	}
@@ -618,6 +1032,10 @@ func (c *Client) updateFollowURL(enabled bool) {
 func (c *Client) applyConfig(cfg config.Config) {
 	c.mu.Lock()
 	previousEndpoint := c.cfg.Endpoint
+	if strings.TrimSpace(cfg.ClientIdentity) == "" {
+		cfg.ClientIdentity = c.cfg.ClientIdentity
+	}
+	cfg.ResumeToken = c.cfg.ResumeToken
 	c.cfg = cfg
 	c.mu.Unlock()
 
@@ -637,9 +1055,21 @@ func (c *Client) applyConfig(cfg config.Config) {
 }
 
 func (c *Client) sendCreateRoom() {
+	requestID := c.trackRoomRequest()
+	c.mu.Lock()
+	password := c.desiredPassword
+	policy := c.desiredJoinPolicy
+	premiere := c.desiredPremiere
+	c.mu.Unlock()
 	env := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_CreateRoomReq{
-			CreateRoomReq: &videowithyoupb.CreateRoomReq{ClientId: c.clientID},
+			CreateRoomReq: &videowithyoupb.CreateRoomReq{
+				ClientId:   c.clientID,
+				RequestId:  requestID,
+				Password:   password,
+				JoinPolicy: policy,
+				Premiere:   premiere,
+			},
 		},
 	}
 	c.wsClient.Send(env)
@@ -649,31 +1079,80 @@ func (c *Client) sendJoinRoom(code string) {
 	if code == "" {
 		return
 	}
+	requestID := c.trackRoomRequest()
+	c.mu.Lock()
+	password := c.desiredPassword
+	c.mu.Unlock()
 	env := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_JoinRoomReq{
 			JoinRoomReq: &videowithyoupb.JoinRoomReq{
-				ClientId: c.clientID,
-				RoomCode: code,
+				ClientId:  c.clientID,
+				RoomCode:  code,
+				RequestId: requestID,
+				Password:  password,
 			},
 		},
 	}
 	c.wsClient.Send(env)
 }
 
-func (c *Client) sendLeaveRoom() {
+func (c *Client) nextRequestID() string {
+	return "r" + strconv.FormatUint(c.requestSeq.Add(1), 10)
+}
+
+// trackRoomRequest allocates the request id for a create/join so a failure
+// for exactly that request clears pendingRoomAction.
+func (c *Client) trackRoomRequest() string {
+	requestID := c.nextRequestID()
+	c.mu.Lock()
+	c.pendingRequestID = requestID
+	c.mu.Unlock()
+	return requestID
+}
+
+func (c *Client) sendLeaveRoom(successorID string) {
 	c.mu.Lock()
 	roomID := c.roomID
+	awaitingApproval := c.awaitingApproval
 	c.clearRoomLocked()
 	c.mu.Unlock()
 
-	if roomID == "" {
+	if roomID == "" && !awaitingApproval {
 		return
 	}
 	env := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_LeaveRoomReq{
 			LeaveRoomReq: &videowithyoupb.LeaveRoomReq{
-				ClientId: c.clientID,
-				RoomId:   roomID,
+				ClientId:    c.clientID,
+				RoomId:      roomID,
+				SuccessorId: successorID,
+				RequestId:   c.nextRequestID(),
+			},
+		},
+	}
+	c.wsClient.Send(env)
+	c.sendUIState()
+}
+
+func (c *Client) sendJoinDecision(memberID string, approve bool) {
+	c.mu.Lock()
+	roomID := c.roomID
+	if c.role != RoleHost || memberID == "" {
+		c.lastError = "only the host can answer join requests"
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	delete(c.pendingJoins, memberID)
+	c.mu.Unlock()
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_JoinDecision{
+			JoinDecision: &videowithyoupb.JoinDecision{
+				RoomId:    roomID,
+				MemberId:  memberID,
+				Approve:   approve,
+				RequestId: c.nextRequestID(),
 			},
 		},
 	}
@@ -681,6 +1160,120 @@ func (c *Client) sendLeaveRoom() {
 	c.sendUIState()
 }
 
+// sendModeration sends a kick, ban or mute request for memberID.
+func (c *Client) sendModeration(action, memberID string, muted bool) {
+	c.mu.Lock()
+	roomID := c.roomID
+	if c.role != RoleHost || memberID == "" {
+		c.lastError = "only the host can moderate members"
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	c.mu.Unlock()
+
+	requestID := c.nextRequestID()
+	env := &videowithyoupb.Envelope{}
+	switch action {
+	case "kick_member":
+		env.Payload = &videowithyoupb.Envelope_KickMemberReq{
+			KickMemberReq: &videowithyoupb.KickMemberReq{RoomId: roomID, MemberId: memberID, RequestId: requestID},
+		}
+	case "ban_member":
+		env.Payload = &videowithyoupb.Envelope_BanMemberReq{
+			BanMemberReq: &videowithyoupb.BanMemberReq{RoomId: roomID, MemberId: memberID, RequestId: requestID},
+		}
+	case "mute_member":
+		env.Payload = &videowithyoupb.Envelope_MuteMemberReq{
+			MuteMemberReq: &videowithyoupb.MuteMemberReq{RoomId: roomID, MemberId: memberID, Muted: muted, RequestId: requestID},
+		}
+	default:
+		return
+	}
+	c.wsClient.Send(env)
+}
+
+// sendUpdateRoomSettings sends the settings action sets; the rest are left
+// as they are.
+func (c *Client) sendUpdateRoomSettings(action UIAction) {
+	c.mu.Lock()
+	roomID := c.roomID
+	if c.role != RoleHost {
+		c.lastError = "only the host can change room settings"
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	c.mu.Unlock()
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_UpdateRoomSettingsReq{
+			UpdateRoomSettingsReq: &videowithyoupb.UpdateRoomSettingsReq{
+				RoomId:              roomID,
+				RequestId:           c.nextRequestID(),
+				PauseOnBuffering:    action.PauseOnBuffering,
+				MembersEditPlaylist: action.MembersEditPlaylist,
+				Public:              action.Public,
+				Title:               action.Title,
+				Description:         action.Description,
+			},
+		},
+	}
+	if action.ControlMode != "" {
+		mode := parseControlMode(action.ControlMode)
+		env.GetUpdateRoomSettingsReq().ControlMode = &mode
+	}
+	c.wsClient.Send(env)
+}
+
+func (c *Client) sendTransferHost(targetID string) {
+	c.mu.Lock()
+	roomID := c.roomID
+	role := c.role
+	if role != RoleHost || targetID == "" {
+		c.lastError = "only the host can transfer host"
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	c.mu.Unlock()
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_TransferHostReq{
+			TransferHostReq: &videowithyoupb.TransferHostReq{
+				RoomId:    roomID,
+				TargetId:  targetID,
+				RequestId: c.nextRequestID(),
+			},
+		},
+	}
+	c.wsClient.Send(env)
+}
+
+// applyHostChangeLocked switches this client between follower and host when
+// the room's host_id moves to or away from it. A demoted host rejoins by code
+// after a reconnect instead of creating a fresh room.
+func (c *Client) applyHostChangeLocked() (Role, bool) {
+	if c.clientID == "" || c.hostID == "" {
+		return c.role, false
+	}
+	switch {
+	case c.role == RoleFollower && c.hostID == c.clientID:
+		c.role = RoleHost
+	case c.role == RoleHost && c.hostID != c.clientID:
+		c.role = RoleFollower
+		c.desiredRole = RoleFollower
+		c.desiredRoom = c.roomCode
+	default:
+		return c.role, false
+	}
+	c.resetEndpointStatusLocked()
+	c.syncHealth = nil
+	c.lastReportAt = time.Time{}
+	c.controlRequests = nil
+	return c.role, true
+}
+
 func (c *Client) clearRoomLocked() {
 	c.roomID = ""
 	c.roomCode = ""
@@ -702,7 +1295,41 @@ func (c *Client) clearRoomLocked() {
 	c.roomEvents = nil
 	c.members = nil
 	c.pendingRoomAction = false
+	c.pendingRequestID = ""
+	c.desiredPassword = ""
+	c.desiredJoinPolicy = videowithyoupb.JoinPolicy_JOIN_POLICY_OPEN
+	c.joinPolicy = videowithyoupb.JoinPolicy_JOIN_POLICY_OPEN
+	c.pendingJoins = nil
+	c.mutedMembers = nil
+	c.awaitingApproval = false
 	c.lastError = ""
+	c.syncHealth = nil
+	c.lastSyncAction = syncer.ActionNone
+	c.lastSyncActionAt = time.Time{}
+	c.lastReportAt = time.Time{}
+	c.bufferingSince = time.Time{}
+	c.pauseOnBuffering = false
+	c.groupHold = false
+	c.groupWaiting = nil
+	c.groupGen++
+	c.readyCheck = nil
+	c.desiredPremiere = nil
+	c.premiere = nil
+	c.premiereStartMs = 0
+	c.controlMode = videowithyoupb.ControlMode_CONTROL_MODE_HOST_ONLY
+	c.controlGraceUntil = time.Time{}
+	c.controlRequests = nil
+	c.chat = nil
+	c.reactions = nil
+	c.reactionMedia = ""
+	c.reactionCursorSet = false
+	c.playlist = nil
+	c.playlistSeen = false
+	c.playlistAdvanced = false
+	c.membersEditPlaylist = false
+	c.roomPublic = false
+	c.roomTitle = ""
+	c.roomDescription = ""
 }
 
 func (c *Client) resetEndpointStatusLocked() {
@@ -710,6 +1337,7 @@ func (c *Client) resetEndpointStatusLocked() {
 	c.endpointInactiveAt = time.Time{}
 	c.reportedEndpointSet = false
 	c.reportedEndpointActive = false
+	c.reportedBuffering = false
 }
 
 func (c *Client) syncLoop(ctx context.Context) {
@@ -740,9 +1368,18 @@ func (c *Client) handleTick() {
 	prevInactiveAt := c.endpointInactiveAt
 	reportedSet := c.reportedEndpointSet
 	reportedActive := c.reportedEndpointActive
+	groupHold := c.groupHold
+	controlMode := c.controlMode
+	controlGraceUntil := c.controlGraceUntil
 	c.mu.Unlock()
 
 	active := isEndpointActive(now, endpoint, adapter, lastExtSeen, extIdleTimeoutSec)
+	stalled := false
+	if active && adapter != nil {
+		if state, ok := adapter.GetState(); ok {
+			stalled = state.Buffering
+		}
+	}
 	statusChanged := active != prevActive
 	if statusChanged {
 		c.log.Printf("endpoint %s active=%t", endpoint, active)
@@ -759,96 +1396,976 @@ func (c *Client) handleTick() {
 	c.mu.Lock()
 	c.endpointActive = active
 	c.endpointInactiveAt = endpointInactiveAt
+	if !stalled {
+		c.bufferingSince = time.Time{}
+	} else if c.bufferingSince.IsZero() {
+		c.bufferingSince = now
+	}
+	// Report a stall once it has lasted, and recovery right away.
+	buffering := stalled && now.Sub(c.bufferingSince) >= bufferingReportDelay
+	bufferingChanged := buffering != c.reportedBuffering
+	if bufferingChanged {
+		c.reportedBuffering = buffering
+	}
 	if reportStatus {
 		c.reportedEndpointSet = true
 		c.reportedEndpointActive = active
 	}
 	c.mu.Unlock()
 
-	if reportStatus && role == RoleFollower && roomID != "" {
-		c.sendMemberStatus(roomID, active)
+	if roomID != "" && ((reportStatus && role == RoleFollower) || bufferingChanged) {
+		c.sendMemberStatus(roomID, active, buffering)
 	}
 
 	if !active {
 		if role == RoleFollower && roomID != "" && endpointInactiveTimeoutSec > 0 && !endpointInactiveAt.IsZero() {
 			if now.Sub(endpointInactiveAt) >= time.Duration(endpointInactiveTimeoutSec)*time.Second {
 				c.log.Printf("endpoint inactive >%ds, leaving room", endpointInactiveTimeoutSec)
-				c.sendLeaveRoom()
+				c.sendLeaveRoom("")
 			}
 		}
 		return
 	}
 
+	if roomID != "" {
+		c.showDueReactions(adapter, role, hostState, localOffset)
+	}
 	if role == RoleHost {
+		c.advancePlaylistIfEnded(roomID, adapter)
 		c.sendHostState(roomID, offsetMs)
 		return
 	}
-	if role == RoleFollower {
-		c.syncer.Align(hostState, offsetMs, localOffset)
+	if role == RoleFollower && !groupHold {
+		if controlMode != videowithyoupb.ControlMode_CONTROL_MODE_HOST_ONLY {
+			if intent, ok := c.syncer.DetectIntent(hostState, offsetMs, localOffset); ok {
+				c.sendControlIntent(roomID, intent)
+				if controlMode == videowithyoupb.ControlMode_CONTROL_MODE_SHARED {
+					controlGraceUntil = now.Add(controlGrace)
+					c.mu.Lock()
+					c.controlGraceUntil = controlGraceUntil
+					c.mu.Unlock()
+				}
+			}
+		}
+		if now.Before(controlGraceUntil) {
+			return
+		}
+		result := c.syncer.Align(hostState, offsetMs, localOffset)
+		c.reportAlign(roomID, endpoint, offsetMs, result, now)
 	}
 }
 
-func (c *Client) sendHostState(roomID string, offsetMs int64) {
-	if roomID == "" || c.adapter == nil {
+// reportAlign records the outcome of an Align pass and sends a
+// FollowerReport every followerReportInterval.
+func (c *Client) reportAlign(roomID, endpoint string, offsetMs int64, result syncer.Result, now time.Time) {
+	if !result.OK || roomID == "" {
 		return
 	}
-	state, ok := c.adapter.GetState()
-	if !ok {
-		return
+	c.mu.Lock()
+	if result.Action != syncer.ActionNone {
+		c.lastSyncAction = result.Action
+		c.lastSyncActionAt = now
 	}
-	if time.Since(state.UpdatedAt) > 5*time.Second {
+	if now.Sub(c.lastReportAt) < followerReportInterval || !c.serverSupportsLocked(capFollowerReports) {
+		c.mu.Unlock()
 		return
 	}
-
-	c.mu.Lock()
-	now := time.Now()
-	seq := uint64(now.UnixNano())
-	hostID := c.clientID
-	localOffset := c.cfg.OffsetMS
-	endpoint := c.cfg.Endpoint
+	c.lastReportAt = now
+	report := &videowithyoupb.FollowerReport{
+		RoomId:             roomID,
+		MemberId:           c.clientID,
+		DriftMs:            result.DriftMs,
+		PositionMs:         result.PositionMs,
+		Rate:               result.Rate,
+		Endpoint:           endpoint,
+		LastAction:         syncActionProto(c.lastSyncAction),
+		SampleServerTimeMs: now.UnixMilli() + offsetMs,
+	}
+	if !c.lastSyncActionAt.IsZero() {
+		report.LastActionServerTimeMs = c.lastSyncActionAt.UnixMilli() + offsetMs
+	}
 	c.mu.Unlock()
 
-	sampleServerTime := now.UnixMilli() + offsetMs
-	hostState := &videowithyoupb.HostState{
-		RoomId:             roomID,
-		HostId:             hostID,
-		Seq:                seq,
-		PositionMs:         state.PositionMs,
-		Rate:               state.Rate,
-		Paused:             state.Paused,
-		SampleServerTimeMs: sampleServerTime,
-		OffsetMs:           localOffset,
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_FollowerReport{FollowerReport: report},
+	})
+}
+
+func (c *Client) handleSyncHealth(health *videowithyoupb.SyncHealth) {
+	if health == nil {
+		return
 	}
-	if endpoint != "mpc" && (state.Media.URL != "" || state.Media.Title != "") {
-		hostState.Media = &videowithyoupb.MediaInfo{
-			Url:   state.Media.URL,
-			Title: state.Media.Title,
-			Site:  state.Media.Site,
-			Attrs: state.Media.Attrs,
+	serverNow := time.Now().UnixMilli() + c.offsetMs.Load()
+	members := make([]UIMemberSync, 0, len(health.Members))
+	for _, member := range health.Members {
+		entry := UIMemberSync{
+			MemberID:    member.MemberId,
+			DisplayName: strings.TrimSpace(member.DisplayName),
+			DriftMs:     member.DriftMs,
+			Endpoint:    member.Endpoint,
+			LastAction:  syncActionName(member.LastAction),
+			Quality:     syncQuality(member.DriftMs),
+			ReportAgeMs: member.ReportAgeMs,
 		}
+		if member.LastActionServerTimeMs > 0 {
+			entry.LastActionAgeMs = serverNow - member.LastActionServerTimeMs
+		}
+		members = append(members, entry)
 	}
 
-	env := &videowithyoupb.Envelope{
-		Payload: &videowithyoupb.Envelope_HostState{HostState: hostState},
+	c.mu.Lock()
+	if c.role != RoleHost || health.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
 	}
-	c.wsClient.Send(env)
+	c.syncHealth = members
+	c.mu.Unlock()
+	c.sendUIState()
 }
 
-func (c *Client) sendMemberStatus(roomID string, active bool) {
-	if roomID == "" {
+// handleGroupPlayback pauses the local player for a buffering hold, or
+// schedules the shared resume when the hold is released.
+func (c *Client) handleGroupPlayback(msg *videowithyoupb.GroupPlayback) {
+	if msg == nil {
 		return
 	}
-	env := &videowithyoupb.Envelope{
-		Payload: &videowithyoupb.Envelope_MemberStatus{
-			MemberStatus: &videowithyoupb.MemberStatus{
-				RoomId:   roomID,
-				MemberId: c.clientID,
-				Active:   active,
-			},
-		},
+	c.mu.Lock()
+	if msg.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
 	}
-	c.wsClient.Send(env)
-}
+	target := c.groupTargetLocked(msg.PositionMs)
+	wasHeld := len(c.groupWaiting) > 0
+	c.groupGen++
+	gen := c.groupGen
+	c.groupHold = true
+	c.groupWaiting = c.groupWaiting[:0]
+	for _, id := range msg.WaitingMemberIds {
+		c.groupWaiting = append(c.groupWaiting, c.memberNameLocked(id))
+	}
+	names := append([]string(nil), c.groupWaiting...)
+	adapter := c.adapter
+	c.mu.Unlock()
+
+	rate := msg.Rate
+	if rate <= 0 {
+		rate = 1
+	}
+	c.applyGroupState(adapter, model.ApplyState{PositionMs: target, Paused: true, Rate: rate})
+
+	var events []string
+	if msg.Paused {
+		if !wasHeld {
+			events = append(events, formatBufferHoldEvent(names))
+		}
+	} else {
+		c.scheduleGroupStart(gen, msg.ResumeAtServerTimeMs, rate)
+		events = append(events, formatBufferReleaseEvent())
+	}
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+	c.sendUIState()
+}
+
+// applyGroupState applies a room-wide pause or start to the local player.
+func (c *Client) applyGroupState(endpoint adapter.Endpoint, state model.ApplyState) {
+	if endpoint == nil {
+		return
+	}
+	c.syncer.MarkApplied()
+	_ = endpoint.ApplyState(state)
+}
+
+// groupTargetLocked maps a position on the host's timeline to the local
+// player the same way Align does. It is called with c.mu held.
+func (c *Client) groupTargetLocked(positionMs int64) int64 {
+	if c.role != RoleFollower {
+		return positionMs
+	}
+	if c.lastHostState != nil {
+		positionMs += c.lastHostState.OffsetMs
+	}
+	return positionMs + c.cfg.OffsetMS
+}
+
+// scheduleGroupStart unpauses the local player at the given server time,
+// unless group playback generation gen has been superseded by then. The
+// player should already be paused at the start position.
+func (c *Client) scheduleGroupStart(gen uint64, startAtServerMs int64, rate float64) {
+	delay := time.Duration(startAtServerMs-(time.Now().UnixMilli()+c.offsetMs.Load())) * time.Millisecond
+	if delay < 0 {
+		delay = 0
+	}
+	time.AfterFunc(delay, func() {
+		c.mu.Lock()
+		if gen != c.groupGen {
+			c.mu.Unlock()
+			return
+		}
+		c.groupHold = false
+		adapter := c.adapter
+		c.mu.Unlock()
+
+		c.applyGroupState(adapter, model.ApplyState{PositionMs: -1, Paused: false, Rate: rate})
+		c.sendUIState()
+	})
+}
+
+// applyPremiereLocked records the room's premiere and returns a group
+// playback generation when a start not yet scheduled locally lies ahead. It
+// is called with c.mu held.
+func (c *Client) applyPremiereLocked(premiere *videowithyoupb.Premiere) uint64 {
+	if premiere == nil {
+		c.premiere = nil
+		return 0
+	}
+	startMs := premiere.StartServerTimeMs
+	c.premiere = &UIPremiere{
+		StartMs:    startMs - c.offsetMs.Load(),
+		MediaURL:   premiere.GetMedia().GetUrl(),
+		MediaTitle: premiere.GetMedia().GetTitle(),
+	}
+	if startMs == c.premiereStartMs || startMs <= time.Now().UnixMilli()+c.offsetMs.Load() {
+		return 0
+	}
+	c.premiereStartMs = startMs
+	c.groupGen++
+	return c.groupGen
+}
+
+// schedulePremiere opens the premiere media on the host and, shortly before
+// the start, pauses the local player at the start position and schedules
+// the shared start. Followers already hold at 0 through Align; this makes
+// the start itself exact.
+func (c *Client) schedulePremiere(gen uint64, premiere *videowithyoupb.Premiere, role Role) {
+	c.mu.Lock()
+	endpoint := c.cfg.Endpoint
+	lastExtURL := c.lastExtURL
+	c.mu.Unlock()
+
+	// The host opens the media itself, whatever follow_url says; that
+	// setting only governs following someone else's navigation.
+	url := premiere.GetMedia().GetUrl()
+	if role == RoleHost && endpoint == "browser" && url != "" && c.shouldNavigate(url, lastExtURL) {
+		c.navigateExtension(url)
+	}
+
+	startMs := premiere.StartServerTimeMs
+	delay := time.Duration(startMs-(time.Now().UnixMilli()+c.offsetMs.Load()))*time.Millisecond - premiereLead
+	if delay < 0 {
+		delay = 0
+	}
+	time.AfterFunc(delay, func() {
+		c.mu.Lock()
+		if gen != c.groupGen {
+			c.mu.Unlock()
+			return
+		}
+		target := c.groupTargetLocked(0)
+		c.groupHold = true
+		adapter := c.adapter
+		c.mu.Unlock()
+
+		c.applyGroupState(adapter, model.ApplyState{PositionMs: target, Paused: true, Rate: 1})
+		c.scheduleGroupStart(gen, startMs, 1)
+	})
+}
+
+func (c *Client) sendControlIntent(roomID string, intent syncer.Intent) {
+	c.mu.Lock()
+	supported := c.serverSupportsLocked(capSharedControl)
+	c.mu.Unlock()
+	if !supported {
+		return
+	}
+	msg := &videowithyoupb.ControlIntent{
+		RoomId:     roomID,
+		IntentId:   c.nextRequestID(),
+		Action:     controlActionProto(intent.Action),
+		PositionMs: intent.PositionMs,
+	}
+	c.log.Printf("control intent action=%s position=%d", msg.Action, msg.PositionMs)
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ControlIntent{ControlIntent: msg},
+	})
+}
+
+// handleControlIntent applies a follower's play, pause or seek on the host,
+// or queues it for the host's approval.
+func (c *Client) handleControlIntent(intent *videowithyoupb.ControlIntent) {
+	if intent == nil {
+		return
+	}
+	c.mu.Lock()
+	if c.role != RoleHost || intent.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
+	}
+	if intent.RequiresApproval {
+		if c.controlRequests == nil {
+			c.controlRequests = make(map[string]*videowithyoupb.ControlIntent)
+		}
+		c.controlRequests[intent.MemberId] = intent
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	c.mu.Unlock()
+
+	c.applyControlIntent(intent)
+	events := []string{formatControlEvent(intent.DisplayName, intent.Action)}
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+}
+
+func (c *Client) answerControlRequest(memberID string, approve bool) {
+	c.mu.Lock()
+	intent := c.controlRequests[memberID]
+	delete(c.controlRequests, memberID)
+	roomID := c.roomID
+	c.mu.Unlock()
+	if intent == nil {
+		c.sendUIState()
+		return
+	}
+
+	var events []string
+	if approve {
+		c.applyControlIntent(intent)
+		events = append(events, formatControlEvent(intent.DisplayName, intent.Action))
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ControlDecision{
+			ControlDecision: &videowithyoupb.ControlDecision{
+				RoomId:   roomID,
+				IntentId: intent.IntentId,
+				MemberId: memberID,
+				Approve:  approve,
+			},
+		},
+	})
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+	c.sendUIState()
+}
+
+// applyControlIntent makes the change on the host's endpoint; it reaches the
+// room with the next HostState.
+func (c *Client) applyControlIntent(intent *videowithyoupb.ControlIntent) {
+	if c.adapter == nil {
+		return
+	}
+	state, ok := c.adapter.GetState()
+	if !ok {
+		return
+	}
+	apply := model.ApplyState{PositionMs: -1, Paused: state.Paused, Rate: state.Rate}
+	if apply.Rate <= 0 {
+		apply.Rate = 1
+	}
+	switch intent.Action {
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PLAY:
+		apply.Paused = false
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PAUSE:
+		apply.Paused = true
+	case videowithyoupb.ControlAction_CONTROL_ACTION_SEEK:
+		apply.PositionMs = intent.PositionMs
+	default:
+		return
+	}
+	c.log.Printf("control applied member=%s action=%s", intent.MemberId, intent.Action)
+	_ = c.adapter.ApplyState(apply)
+}
+
+func (c *Client) handleControlDecision(decision *videowithyoupb.ControlDecision) {
+	if decision == nil || decision.Approve {
+		return
+	}
+	c.mu.Lock()
+	if decision.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	events := []string{"\u623f\u4e3b\u62d2\u7edd\u4e86\u4f60\u7684\u63a7\u5236\u8bf7\u6c42"}
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+	c.sendUIState()
+}
+
+func (c *Client) sendChat(text string) {
+	if !c.requireServerCapability(capChat) {
+		return
+	}
+	text = strings.TrimSpace(text)
+	c.mu.Lock()
+	roomID := c.roomID
+	c.mu.Unlock()
+	if roomID == "" || text == "" {
+		return
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ChatMessage{
+			ChatMessage: &videowithyoupb.ChatMessage{
+				RoomId:    roomID,
+				Text:      text,
+				RequestId: c.nextRequestID(),
+			},
+		},
+	})
+}
+
+// handleChatMessage keeps a relayed chat message and passes it to the
+// extension as a chat_message.
+func (c *Client) handleChatMessage(msg *videowithyoupb.ChatMessage) {
+	if msg == nil {
+		return
+	}
+	c.mu.Lock()
+	if msg.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
+	}
+	entry := c.appendChatLocked(msg)
+	c.mu.Unlock()
+
+	_ = c.extHost.Send(map[string]any{
+		"type":    "chat_message",
+		"payload": entry,
+	})
+}
+
+// appendChatLocked adds msg to the kept chat and returns its UI form. It is
+// called with c.mu held.
+func (c *Client) appendChatLocked(msg *videowithyoupb.ChatMessage) UIChatMessage {
+	entry := UIChatMessage{
+		MessageID:    msg.MessageId,
+		MemberID:     msg.MemberId,
+		DisplayName:  strings.TrimSpace(msg.DisplayName),
+		Text:         msg.Text,
+		ServerTimeMs: msg.ServerTimeMs,
+		Self:         msg.MemberId == c.clientID,
+	}
+	c.chat = append(c.chat, entry)
+	if len(c.chat) > maxChatMessages {
+		c.chat = append([]UIChatMessage(nil), c.chat[len(c.chat)-maxChatMessages:]...)
+	}
+	return entry
+}
+
+func (c *Client) sendListRooms() {
+	if !c.requireServerCapability(capDirectory) {
+		return
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ListRoomsReq{
+			ListRoomsReq: &videowithyoupb.ListRoomsReq{RequestId: c.nextRequestID()},
+		},
+	})
+}
+
+// handleListRoomsResp keeps the public room directory for the popup, which
+// joins one with join_room and its room_code.
+func (c *Client) handleListRoomsResp(resp *videowithyoupb.ListRoomsResp) {
+	if resp == nil {
+		return
+	}
+	rooms := make([]UIPublicRoom, 0, len(resp.Rooms))
+	for _, room := range resp.Rooms {
+		rooms = append(rooms, UIPublicRoom{
+			RoomCode:    room.RoomCode,
+			Title:       room.Title,
+			Description: room.Description,
+			HostName:    room.HostName,
+			MemberCount: int(room.MemberCount),
+			MediaTitle:  room.MediaTitle,
+			Playing:     room.Playing,
+			JoinPolicy:  joinPolicyName(room.JoinPolicy),
+		})
+	}
+	c.mu.Lock()
+	c.publicRooms = rooms
+	c.mu.Unlock()
+	c.sendUIState()
+}
+
+// hostTimelinePosition maps a local player position onto the host's
+// timeline, undoing the offsets a follower plays at.
+func hostTimelinePosition(role Role, positionMs int64, host *videowithyoupb.HostState, localOffset int64) int64 {
+	if role != RoleFollower {
+		return positionMs
+	}
+	if host != nil {
+		positionMs -= host.OffsetMs
+	}
+	return positionMs - localOffset
+}
+
+func (c *Client) sendReaction(emoji, text string) {
+	if !c.requireServerCapability(capReactions) {
+		return
+	}
+	emoji = strings.TrimSpace(emoji)
+	text = strings.TrimSpace(text)
+	c.mu.Lock()
+	roomID := c.roomID
+	role := c.role
+	host := c.lastHostState
+	localOffset := c.cfg.OffsetMS
+	adapter := c.adapter
+	c.mu.Unlock()
+	if roomID == "" || (emoji == "" && text == "") || adapter == nil {
+		return
+	}
+	state, ok := adapter.GetState()
+	if !ok {
+		return
+	}
+	position := hostTimelinePosition(role, state.PositionMs, host, localOffset)
+	if position < 0 {
+		position = 0
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_Reaction{
+			Reaction: &videowithyoupb.Reaction{
+				RoomId:     roomID,
+				PositionMs: position,
+				Emoji:      emoji,
+				Text:       text,
+				RequestId:  c.nextRequestID(),
+			},
+		},
+	})
+}
+
+// handleReactionBatch replaces the kept reactions with those stored for the
+// room's current media.
+func (c *Client) handleReactionBatch(batch *videowithyoupb.ReactionBatch) {
+	if batch == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if batch.RoomId != c.roomID {
+		return
+	}
+	c.reactions = append([]*videowithyoupb.Reaction(nil), batch.Reactions...)
+	sort.SliceStable(c.reactions, func(i, j int) bool { return c.reactions[i].PositionMs < c.reactions[j].PositionMs })
+	if c.reactionMedia != batch.MediaUrl {
+		c.reactionMedia = batch.MediaUrl
+		c.reactionCursorSet = false
+	}
+}
+
+// handleReaction keeps a relayed reaction for its position. One that lands
+// just behind local playback is shown right away; later ones wait for
+// playback to reach them.
+func (c *Client) handleReaction(msg *videowithyoupb.Reaction) {
+	if msg == nil {
+		return
+	}
+	c.mu.Lock()
+	if msg.RoomId != c.roomID || msg.MediaUrl != c.reactionMedia {
+		c.mu.Unlock()
+		return
+	}
+	i := sort.Search(len(c.reactions), func(i int) bool { return c.reactions[i].PositionMs > msg.PositionMs })
+	c.reactions = slices.Insert(c.reactions, i, msg)
+	passed := c.reactionCursorSet && msg.PositionMs <= c.reactionCursor &&
+		c.reactionCursor-msg.PositionMs <= reactionCatchUp.Milliseconds()
+	var entry UIReaction
+	if passed {
+		entry = c.uiReactionLocked(msg)
+	}
+	c.mu.Unlock()
+
+	if passed {
+		c.pushReaction(entry)
+	}
+}
+
+// showDueReactions pushes the reactions local playback passed since the
+// last tick.
+func (c *Client) showDueReactions(player adapter.Endpoint, role Role, host *videowithyoupb.HostState, localOffset int64) {
+	if player == nil {
+		return
+	}
+	state, ok := player.GetState()
+	if !ok {
+		return
+	}
+	position := hostTimelinePosition(role, state.PositionMs, host, localOffset)
+
+	c.mu.Lock()
+	from, advanced := c.reactionCursor, c.reactionCursorSet && position > c.reactionCursor
+	c.reactionCursor = position
+	c.reactionCursorSet = true
+	if !advanced || state.Paused || position-from > reactionCatchUp.Milliseconds() {
+		c.mu.Unlock()
+		return
+	}
+	var due []UIReaction
+	i := sort.Search(len(c.reactions), func(i int) bool { return c.reactions[i].PositionMs > from })
+	for ; i < len(c.reactions) && c.reactions[i].PositionMs <= position; i++ {
+		due = append(due, c.uiReactionLocked(c.reactions[i]))
+	}
+	c.mu.Unlock()
+
+	for _, entry := range due {
+		c.pushReaction(entry)
+	}
+}
+
+func (c *Client) uiReactionLocked(msg *videowithyoupb.Reaction) UIReaction {
+	return UIReaction{
+		ReactionID:  msg.ReactionId,
+		MemberID:    msg.MemberId,
+		DisplayName: strings.TrimSpace(msg.DisplayName),
+		PositionMs:  msg.PositionMs,
+		Emoji:       msg.Emoji,
+		Text:        msg.Text,
+		Self:        msg.MemberId == c.clientID,
+	}
+}
+
+func (c *Client) pushReaction(entry UIReaction) {
+	_ = c.extHost.Send(map[string]any{
+		"type":    "reaction",
+		"payload": entry,
+	})
+}
+
+// applyPlaylistLocked stores the room's playlist from a snapshot. It returns
+// the URL to open when the current entry changed after the first snapshot,
+// so joining a room does not navigate. It is called with c.mu held.
+func (c *Client) applyPlaylistLocked(list *videowithyoupb.Playlist) string {
+	previous := c.playlist.GetCurrent().GetEntryId()
+	seen := c.playlistSeen
+	c.playlist = list
+	c.playlistSeen = true
+	current := list.GetCurrent()
+	if current.GetEntryId() == previous {
+		return ""
+	}
+	c.playlistAdvanced = false
+	if !seen {
+		return ""
+	}
+	return current.GetMedia().GetUrl()
+}
+
+// navigatePlaylist opens the playlist's new current entry. The host always
+// follows its queue; followers only with follow_url.
+func (c *Client) navigatePlaylist(role Role, player adapter.Endpoint, url, currentURL string) {
+	if role != RoleHost {
+		_ = player.Navigate(url)
+		return
+	}
+	if !c.shouldNavigate(url, currentURL) {
+		return
+	}
+	c.navigateExtension(url)
+}
+
+// navigateExtension tells the browser extension to open url. Unlike the
+// browser adapter's Navigate it ignores follow_url.
+func (c *Client) navigateExtension(url string) {
+	_ = c.extHost.Send(map[string]any{
+		"type": "navigate",
+		"payload": map[string]any{
+			"url": url,
+		},
+	})
+}
+
+// advancePlaylistIfEnded asks the server for the next playlist entry once
+// the host's player reports the current media ended.
+func (c *Client) advancePlaylistIfEnded(roomID string, player adapter.Endpoint) {
+	if player == nil {
+		return
+	}
+	state, ok := player.GetState()
+	if !ok || !state.Ended {
+		return
+	}
+	c.mu.Lock()
+	if c.playlistAdvanced || len(c.playlist.GetEntries()) == 0 {
+		c.mu.Unlock()
+		return
+	}
+	c.playlistAdvanced = true
+	entryID := c.playlist.GetCurrent().GetEntryId()
+	c.mu.Unlock()
+
+	c.log.Printf("media ended, advancing playlist from %q", entryID)
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_PlaylistUpdateReq{
+			PlaylistUpdateReq: &videowithyoupb.PlaylistUpdateReq{
+				RoomId:    roomID,
+				RequestId: c.nextRequestID(),
+				Op:        videowithyoupb.PlaylistOp_PLAYLIST_OP_ADVANCE,
+				EntryId:   entryID,
+			},
+		},
+	})
+}
+
+// sendPlaylistAdd queues url, or what the local player has open when url is
+// empty.
+func (c *Client) sendPlaylistAdd(url, title string) {
+	if !c.requireServerCapability(capPlaylist) {
+		return
+	}
+	url = strings.TrimSpace(url)
+	title = strings.TrimSpace(title)
+	c.mu.Lock()
+	roomID := c.roomID
+	player := c.adapter
+	c.mu.Unlock()
+	if roomID == "" {
+		return
+	}
+	media := &videowithyoupb.MediaInfo{Url: url, Title: title}
+	if url == "" && player != nil {
+		if state, ok := player.GetState(); ok {
+			media = &videowithyoupb.MediaInfo{
+				Url:   state.Media.URL,
+				Title: state.Media.Title,
+				Site:  state.Media.Site,
+			}
+		}
+	}
+	if media.Url == "" {
+		return
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_PlaylistUpdateReq{
+			PlaylistUpdateReq: &videowithyoupb.PlaylistUpdateReq{
+				RoomId:    roomID,
+				RequestId: c.nextRequestID(),
+				Op:        videowithyoupb.PlaylistOp_PLAYLIST_OP_ADD,
+				Media:     media,
+			},
+		},
+	})
+}
+
+func (c *Client) sendPlaylistUpdate(op videowithyoupb.PlaylistOp, entryID string, index int32) {
+	if !c.requireServerCapability(capPlaylist) {
+		return
+	}
+	c.mu.Lock()
+	roomID := c.roomID
+	c.mu.Unlock()
+	if roomID == "" || entryID == "" {
+		return
+	}
+	c.wsClient.Send(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_PlaylistUpdateReq{
+			PlaylistUpdateReq: &videowithyoupb.PlaylistUpdateReq{
+				RoomId:    roomID,
+				RequestId: c.nextRequestID(),
+				Op:        op,
+				EntryId:   entryID,
+				Index:     index,
+			},
+		},
+	})
+}
+
+func uiPlaylist(list *videowithyoupb.Playlist, membersEdit bool) UIPlaylist {
+	out := UIPlaylist{
+		Entries:     make([]UIPlaylistEntry, 0, len(list.GetEntries())),
+		MembersEdit: membersEdit,
+	}
+	if current := list.GetCurrent(); current != nil {
+		entry := uiPlaylistEntry(current)
+		out.Current = &entry
+	}
+	for _, entry := range list.GetEntries() {
+		out.Entries = append(out.Entries, uiPlaylistEntry(entry))
+	}
+	return out
+}
+
+func uiPlaylistEntry(entry *videowithyoupb.PlaylistEntry) UIPlaylistEntry {
+	return UIPlaylistEntry{
+		EntryID:     entry.EntryId,
+		URL:         entry.GetMedia().GetUrl(),
+		Title:       entry.GetMedia().GetTitle(),
+		AddedByName: strings.TrimSpace(entry.AddedByName),
+	}
+}
+
+func (c *Client) handleReadyCheck(msg *videowithyoupb.ReadyCheck) {
+	if msg == nil {
+		return
+	}
+	c.mu.Lock()
+	if msg.RoomId != c.roomID {
+		c.mu.Unlock()
+		return
+	}
+	var events []string
+	if msg.Done {
+		c.readyCheck = nil
+		target := c.groupTargetLocked(msg.PositionMs)
+		c.groupGen++
+		gen := c.groupGen
+		c.groupHold = true
+		adapter := c.adapter
+		c.mu.Unlock()
+
+		rate := msg.Rate
+		if rate <= 0 {
+			rate = 1
+		}
+		c.applyGroupState(adapter, model.ApplyState{PositionMs: target, Paused: true, Rate: rate})
+		c.scheduleGroupStart(gen, msg.StartAtServerTimeMs, rate)
+		events = append(events, formatReadyStartEvent(msg.TimedOut))
+	} else {
+		if c.readyCheck == nil || c.readyCheck.CheckID != msg.CheckId {
+			events = append(events, formatReadyCheckEvent())
+		}
+		check := &UIReadyCheck{
+			CheckID:    msg.CheckId,
+			Ready:      make([]string, 0, len(msg.ReadyMemberIds)),
+			Waiting:    make([]string, 0, len(msg.WaitingMemberIds)),
+			DeadlineMs: msg.DeadlineServerTimeMs - c.offsetMs.Load(),
+		}
+		for _, id := range msg.ReadyMemberIds {
+			check.Ready = append(check.Ready, c.memberNameLocked(id))
+			if id == c.clientID {
+				check.SelfReady = true
+			}
+		}
+		for _, id := range msg.WaitingMemberIds {
+			check.Waiting = append(check.Waiting, c.memberNameLocked(id))
+		}
+		c.readyCheck = check
+		c.mu.Unlock()
+	}
+	c.recordRoomEvents(events)
+	c.sendRoomEvents(events)
+	c.sendUIState()
+}
+
+func (c *Client) sendReadyCheck(timeoutSec int64) {
+	if !c.requireServerCapability(capGroupPlayback) {
+		return
+	}
+	c.mu.Lock()
+	roomID := c.roomID
+	if c.role != RoleHost {
+		c.lastError = "only the host can start a ready check"
+		c.mu.Unlock()
+		c.sendUIState()
+		return
+	}
+	c.mu.Unlock()
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ReadyCheckReq{
+			ReadyCheckReq: &videowithyoupb.ReadyCheckReq{
+				RoomId:    roomID,
+				RequestId: c.nextRequestID(),
+				TimeoutMs: timeoutSec * 1000,
+			},
+		},
+	}
+	c.wsClient.Send(env)
+}
+
+func (c *Client) sendReadyCheckAck(ready bool) {
+	c.mu.Lock()
+	roomID := c.roomID
+	if c.readyCheck == nil {
+		c.mu.Unlock()
+		return
+	}
+	checkID := c.readyCheck.CheckID
+	c.mu.Unlock()
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ReadyCheckAck{
+			ReadyCheckAck: &videowithyoupb.ReadyCheckAck{
+				RoomId:  roomID,
+				CheckId: checkID,
+				Ready:   ready,
+			},
+		},
+	}
+	c.wsClient.Send(env)
+}
+
+func (c *Client) sendHostState(roomID string, offsetMs int64) {
+	if roomID == "" || c.adapter == nil {
+		return
+	}
+	state, ok := c.adapter.GetState()
+	if !ok {
+		return
+	}
+	if time.Since(state.UpdatedAt) > 5*time.Second {
+		return
+	}
+
+	c.mu.Lock()
+	now := time.Now()
+	// The server drops states whose seq does not increase; keep it
+	// monotonic even if the wall clock steps back.
+	seq := uint64(now.UnixNano())
+	if seq <= c.hostSeq {
+		seq = c.hostSeq + 1
+	}
+	c.hostSeq = seq
+	hostID := c.clientID
+	localOffset := c.cfg.OffsetMS
+	endpoint := c.cfg.Endpoint
+	c.mu.Unlock()
+
+	sampleServerTime := now.UnixMilli() + offsetMs
+	hostState := &videowithyoupb.HostState{
+		RoomId:             roomID,
+		HostId:             hostID,
+		Seq:                seq,
+		PositionMs:         state.PositionMs,
+		Rate:               state.Rate,
+		Paused:             state.Paused,
+		SampleServerTimeMs: sampleServerTime,
+		OffsetMs:           localOffset,
+	}
+	if endpoint != "mpc" && (state.Media.URL != "" || state.Media.Title != "") {
+		hostState.Media = &videowithyoupb.MediaInfo{
+			Url:   state.Media.URL,
+			Title: state.Media.Title,
+			Site:  state.Media.Site,
+			Attrs: state.Media.Attrs,
+		}
+	}
+
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_HostState{HostState: hostState},
+	}
+	c.wsClient.Send(env)
+}
+
+func (c *Client) sendMemberStatus(roomID string, active, buffering bool) {
+	if roomID == "" {
+		return
+	}
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_MemberStatus{
+			MemberStatus: &videowithyoupb.MemberStatus{
+				RoomId:    roomID,
+				MemberId:  c.clientID,
+				Active:    active,
+				Buffering: buffering,
+			},
+		},
+	}
+	c.wsClient.Send(env)
+}
 
 func isEndpointActive(now time.Time, endpoint string, adapter adapter.Endpoint, lastSeen time.Time, idleTimeoutSec int64) bool {
 	if endpoint == "mpc" {
@@ -974,6 +2491,181 @@ func formatMemberEvent(name string, joined bool) string {
 	return label + "\u0020\u79bb\u5f00\u4e86\u623f\u95f4"
 }
 
+func formatHostEvent(name string) string {
+	label := strings.TrimSpace(name)
+	if label == "" {
+		label = "\u6210\u5458"
+	}
+	return label + "\u0020\u6210\u4e3a\u4e86\u623f\u4e3b"
+}
+
+func formatKnockEvent(name string) string {
+	label := strings.TrimSpace(name)
+	if label == "" {
+		label = "\u6210\u5458"
+	}
+	return label + "\u0020\u8bf7\u6c42\u52a0\u5165\u623f\u95f4"
+}
+
+func syncActionProto(action syncer.Action) videowithyoupb.SyncAction {
+	switch action {
+	case syncer.ActionSeek:
+		return videowithyoupb.SyncAction_SYNC_ACTION_SEEK
+	case syncer.ActionSoftRate:
+		return videowithyoupb.SyncAction_SYNC_ACTION_SOFT_RATE
+	default:
+		return videowithyoupb.SyncAction_SYNC_ACTION_NONE
+	}
+}
+
+func syncActionName(action videowithyoupb.SyncAction) string {
+	switch action {
+	case videowithyoupb.SyncAction_SYNC_ACTION_SEEK:
+		return "seek"
+	case videowithyoupb.SyncAction_SYNC_ACTION_SOFT_RATE:
+		return "soft_rate"
+	default:
+		return "none"
+	}
+}
+
+// syncQuality buckets a follower's drift for the popup.
+func syncQuality(driftMs int64) string {
+	if driftMs < 0 {
+		driftMs = -driftMs
+	}
+	switch {
+	case driftMs < 300:
+		return "good"
+	case driftMs < 1000:
+		return "fair"
+	default:
+		return "poor"
+	}
+}
+
+func formatBufferHoldEvent(names []string) string {
+	return "\u7b49\u5f85\u7f13\u51b2\uff1a" + strings.Join(names, "\u3001")
+}
+
+func formatBufferReleaseEvent() string {
+	return "\u7f13\u51b2\u5b8c\u6210\uff0c\u7ee7\u7eed\u64ad\u653e"
+}
+
+func formatReadyCheckEvent() string {
+	return "\u623f\u4e3b\u53d1\u8d77\u51c6\u5907\u786e\u8ba4"
+}
+
+func formatPlaylistEvent(media *videowithyoupb.MediaInfo) string {
+	label := strings.TrimSpace(media.GetTitle())
+	if label == "" {
+		label = media.GetUrl()
+	}
+	return "\u64ad\u653e\u5217\u8868\uff1a\u6b63\u5728\u64ad\u653e " + label
+}
+
+func formatReadyStartEvent(timedOut bool) string {
+	if timedOut {
+		return "\u51c6\u5907\u8d85\u65f6\uff0c\u5373\u5c06\u5f00\u59cb"
+	}
+	return "\u5168\u5458\u5c31\u7eea\uff0c\u5373\u5c06\u5f00\u59cb"
+}
+
+func formatControlEvent(name string, action videowithyoupb.ControlAction) string {
+	label := strings.TrimSpace(name)
+	if label == "" {
+		label = "\u6210\u5458"
+	}
+	switch action {
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PLAY:
+		return label + "\uff1a\u64ad\u653e"
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PAUSE:
+		return label + "\uff1a\u6682\u505c"
+	default:
+		return label + "\uff1a\u8df3\u8f6c"
+	}
+}
+
+func formatNoticeEvent(message string) string {
+	return "\u670d\u52a1\u5668\u901a\u77e5\uff1a" + message
+}
+
+func newClientIdentity() string {
+	data := make([]byte, 16)
+	_, _ = rand.Read(data)
+	return hex.EncodeToString(data)
+}
+
+func parseJoinPolicy(name string) videowithyoupb.JoinPolicy {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "password":
+		return videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD
+	case "approval":
+		return videowithyoupb.JoinPolicy_JOIN_POLICY_APPROVAL
+	default:
+		return videowithyoupb.JoinPolicy_JOIN_POLICY_OPEN
+	}
+}
+
+func joinPolicyName(policy videowithyoupb.JoinPolicy) string {
+	switch policy {
+	case videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD:
+		return "password"
+	case videowithyoupb.JoinPolicy_JOIN_POLICY_APPROVAL:
+		return "approval"
+	default:
+		return "open"
+	}
+}
+
+func parseControlMode(name string) videowithyoupb.ControlMode {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "shared":
+		return videowithyoupb.ControlMode_CONTROL_MODE_SHARED
+	case "request":
+		return videowithyoupb.ControlMode_CONTROL_MODE_REQUEST
+	default:
+		return videowithyoupb.ControlMode_CONTROL_MODE_HOST_ONLY
+	}
+}
+
+func controlModeName(mode videowithyoupb.ControlMode) string {
+	switch mode {
+	case videowithyoupb.ControlMode_CONTROL_MODE_SHARED:
+		return "shared"
+	case videowithyoupb.ControlMode_CONTROL_MODE_REQUEST:
+		return "request"
+	default:
+		return "host_only"
+	}
+}
+
+func controlActionProto(action syncer.IntentAction) videowithyoupb.ControlAction {
+	switch action {
+	case syncer.IntentPlay:
+		return videowithyoupb.ControlAction_CONTROL_ACTION_PLAY
+	case syncer.IntentPause:
+		return videowithyoupb.ControlAction_CONTROL_ACTION_PAUSE
+	case syncer.IntentSeek:
+		return videowithyoupb.ControlAction_CONTROL_ACTION_SEEK
+	default:
+		return videowithyoupb.ControlAction_CONTROL_ACTION_UNSPECIFIED
+	}
+}
+
+func controlActionName(action videowithyoupb.ControlAction) string {
+	switch action {
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PLAY:
+		return "play"
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PAUSE:
+		return "pause"
+	case videowithyoupb.ControlAction_CONTROL_ACTION_SEEK:
+		return "seek"
+	default:
+		return ""
+	}
+}
+
 func formatSyncTime(t time.Time) string {
 	if t.IsZero() {
 		return "-"
@@ -1019,6 +2711,17 @@ func (c *Client) runInitialTimeSync() {
 	}
 }
 
+// resyncTime reruns the initial time sync unless one is already running.
+func (c *Client) resyncTime() {
+	if !c.resyncing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer c.resyncing.Store(false)
+		c.runInitialTimeSync()
+	}()
+}
+
 func (c *Client) runSingleTimeSync() {
 	sample, ok := c.requestTimeSync()
 	if !ok {
@@ -1055,17 +2758,37 @@ func (c *Client) sendUIState() {
 	c.mu.Lock()
 	events := append([]string(nil), c.roomEvents...)
 	state := UIState{
-		RoomCode:        c.roomCode,
-		Role:            string(c.role),
-		MembersCount:    c.membersCount,
-		Endpoint:        c.cfg.Endpoint,
-		FollowURL:       c.cfg.FollowURL,
-		LastError:       c.lastError,
-		DisplayName:     c.cfg.DisplayName,
-		HostDisplayName: c.hostDisplayName,
-		LastSyncTime:    formatSyncTime(c.lastSyncAt),
-		RoomEvents:      events,
-		ServerConnected: c.serverConnected,
+		RoomCode:         c.roomCode,
+		Role:             string(c.role),
+		MembersCount:     c.membersCount,
+		Endpoint:         c.cfg.Endpoint,
+		FollowURL:        c.cfg.FollowURL,
+		LastError:        c.lastError,
+		DisplayName:      c.cfg.DisplayName,
+		HostDisplayName:  c.hostDisplayName,
+		LastSyncTime:     formatSyncTime(c.lastSyncAt),
+		RoomEvents:       events,
+		ServerConnected:  c.serverConnected,
+		Members:          c.uiMembersLocked(),
+		JoinPolicy:       joinPolicyName(c.joinPolicy),
+		PendingJoins:     c.uiPendingJoinsLocked(),
+		AwaitingApproval: c.awaitingApproval,
+		Muted:            c.mutedMembers[c.clientID],
+		ServerNotice:     c.serverNotice,
+		SyncHealth:       append([]UIMemberSync(nil), c.syncHealth...),
+		PauseOnBuffering: c.pauseOnBuffering,
+		GroupHold:        c.groupHold,
+		WaitingFor:       append([]string(nil), c.groupWaiting...),
+		ReadyCheck:       c.readyCheck,
+		Premiere:         c.premiere,
+		ControlMode:      controlModeName(c.controlMode),
+		ControlRequests:  c.uiControlRequestsLocked(),
+		Chat:             append([]UIChatMessage(nil), c.chat...),
+		Playlist:         uiPlaylist(c.playlist, c.membersEditPlaylist),
+		RoomPublic:       c.roomPublic,
+		RoomTitle:        c.roomTitle,
+		RoomDescription:  c.roomDescription,
+		PublicRooms:      append([]UIPublicRoom(nil), c.publicRooms...),
 	}
 	c.mu.Unlock()
 
@@ -1076,6 +2799,59 @@ func (c *Client) sendUIState() {
 	_ = c.extHost.Send(payload)
 }
 
+// memberNameLocked returns a member's display name for the UI. It is called
+// with c.mu held.
+func (c *Client) memberNameLocked(id string) string {
+	if name := c.members[id]; name != "" {
+		return name
+	}
+	return "\u6210\u5458"
+}
+
+func (c *Client) uiMembersLocked() []UIMember {
+	members := make([]UIMember, 0, len(c.members))
+	for id, name := range c.members {
+		members = append(members, UIMember{
+			MemberID:    id,
+			DisplayName: name,
+			IsHost:      id == c.hostID,
+			Muted:       c.mutedMembers[id],
+			Self:        id == c.clientID,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].IsHost != members[j].IsHost {
+			return members[i].IsHost
+		}
+		return members[i].DisplayName < members[j].DisplayName
+	})
+	return members
+}
+
+func (c *Client) uiControlRequestsLocked() []UIControlRequest {
+	requests := make([]UIControlRequest, 0, len(c.controlRequests))
+	for id, intent := range c.controlRequests {
+		requests = append(requests, UIControlRequest{
+			MemberID:    id,
+			DisplayName: strings.TrimSpace(intent.DisplayName),
+			Action:      controlActionName(intent.Action),
+			PositionMs:  intent.PositionMs,
+		})
+	}
+	return requests
+}
+
+func (c *Client) uiPendingJoinsLocked() []UIMember {
+	pending := make([]UIMember, 0, len(c.pendingJoins))
+	for id, name := range c.pendingJoins {
+		pending = append(pending, UIMember{MemberID: id, DisplayName: name})
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].DisplayName < pending[j].DisplayName
+	})
+	return pending
+}
+
 func (c *Client) sendRoomEvents(events []string) {
 	for _, message := range events {
 		payload := map[string]any{
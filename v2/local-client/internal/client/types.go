@@ -11,17 +11,148 @@ const (
 )
 
 type UIState struct {
-	RoomCode        string   `json:"room_code"`
-	Role            string   `json:"role"`
-	MembersCount    int      `json:"members_count"`
-	Endpoint        string   `json:"endpoint"`
-	FollowURL       bool     `json:"follow_url"`
-	LastError       string   `json:"last_error"`
-	DisplayName     string   `json:"display_name"`
-	HostDisplayName string   `json:"host_display_name"`
-	LastSyncTime    string   `json:"last_sync_time"`
-	RoomEvents      []string `json:"room_events"`
-	ServerConnected bool     `json:"server_connected"`
+	RoomCode        string     `json:"room_code"`
+	Role            string     `json:"role"`
+	MembersCount    int        `json:"members_count"`
+	Endpoint        string     `json:"endpoint"`
+	FollowURL       bool       `json:"follow_url"`
+	LastError       string     `json:"last_error"`
+	DisplayName     string     `json:"display_name"`
+	HostDisplayName string     `json:"host_display_name"`
+	LastSyncTime    string     `json:"last_sync_time"`
+	RoomEvents      []string   `json:"room_events"`
+	ServerConnected bool       `json:"server_connected"`
+	Members         []UIMember `json:"members"`
+	JoinPolicy      string     `json:"join_policy"`
+	// PendingJoins lists knocks the host can accept or reject.
+	PendingJoins []UIMember `json:"pending_joins"`
+	// AwaitingApproval is set while this client's own knock is parked.
+	AwaitingApproval bool `json:"awaiting_approval"`
+	// Muted is set when the host muted this client.
+	Muted bool `json:"muted"`
+	// ServerNotice is the latest operator notice from the server.
+	ServerNotice string `json:"server_notice"`
+	// SyncHealth lists followers' sync quality; only the host receives it.
+	SyncHealth []UIMemberSync `json:"sync_health"`
+	// PauseOnBuffering mirrors the room setting.
+	PauseOnBuffering bool `json:"pause_on_buffering"`
+	// GroupHold is set while the room is paused for buffering members;
+	// WaitingFor names them.
+	GroupHold  bool     `json:"group_hold"`
+	WaitingFor []string `json:"waiting_for"`
+	// ReadyCheck is the ready check in progress, if any.
+	ReadyCheck *UIReadyCheck `json:"ready_check,omitempty"`
+	// Premiere is the room's scheduled start, if any.
+	Premiere *UIPremiere `json:"premiere,omitempty"`
+	// ControlMode is "host_only", "shared" or "request".
+	ControlMode string `json:"control_mode"`
+	// ControlRequests lists followers' changes awaiting the host's approval.
+	ControlRequests []UIControlRequest `json:"control_requests"`
+	// Chat is the room's recent chat, oldest first.
+	Chat []UIChatMessage `json:"chat"`
+	// Playlist is the room's shared queue.
+	Playlist UIPlaylist `json:"playlist"`
+	// RoomPublic, RoomTitle and RoomDescription are the room's directory
+	// listing; PublicRooms is the directory as of the last list_rooms.
+	RoomPublic      bool           `json:"room_public"`
+	RoomTitle       string         `json:"room_title"`
+	RoomDescription string         `json:"room_description"`
+	PublicRooms     []UIPublicRoom `json:"public_rooms"`
+}
+
+type UIPublicRoom struct {
+	RoomCode    string `json:"room_code"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	HostName    string `json:"host_name"`
+	MemberCount int    `json:"member_count"`
+	MediaTitle  string `json:"media_title"`
+	Playing     bool   `json:"playing"`
+	// JoinPolicy tells the popup whether joining needs a password.
+	JoinPolicy string `json:"join_policy"`
+}
+
+type UIPlaylist struct {
+	Current *UIPlaylistEntry  `json:"current,omitempty"`
+	Entries []UIPlaylistEntry `json:"entries"`
+	// MembersEdit mirrors the room's members_edit_playlist setting.
+	MembersEdit bool `json:"members_edit"`
+}
+
+type UIPlaylistEntry struct {
+	EntryID     string `json:"entry_id"`
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	AddedByName string `json:"added_by_name"`
+}
+
+type UIChatMessage struct {
+	MessageID    string `json:"message_id"`
+	MemberID     string `json:"member_id"`
+	DisplayName  string `json:"display_name"`
+	Text         string `json:"text"`
+	ServerTimeMs int64  `json:"server_time_ms"`
+	// Self is set on messages this client sent.
+	Self bool `json:"self"`
+}
+
+// UIReaction is pushed to the extension as a reaction when local playback
+// reaches PositionMs, or right away when it arrives just behind it.
+type UIReaction struct {
+	ReactionID  string `json:"reaction_id"`
+	MemberID    string `json:"member_id"`
+	DisplayName string `json:"display_name"`
+	PositionMs  int64  `json:"position_ms"`
+	Emoji       string `json:"emoji,omitempty"`
+	Text        string `json:"text,omitempty"`
+	// Self is set on reactions this client sent.
+	Self bool `json:"self"`
+}
+
+type UIControlRequest struct {
+	MemberID    string `json:"member_id"`
+	DisplayName string `json:"display_name"`
+	// Action is "play", "pause" or "seek".
+	Action     string `json:"action"`
+	PositionMs int64  `json:"position_ms"`
+}
+
+type UIPremiere struct {
+	// StartMs is the local Unix time in ms the premiere starts at.
+	StartMs    int64  `json:"start_ms"`
+	MediaURL   string `json:"media_url"`
+	MediaTitle string `json:"media_title"`
+}
+
+type UIReadyCheck struct {
+	CheckID string   `json:"check_id"`
+	Ready   []string `json:"ready"`
+	Waiting []string `json:"waiting"`
+	// DeadlineMs is the local Unix time in ms when the room starts anyway.
+	DeadlineMs int64 `json:"deadline_ms"`
+	SelfReady  bool  `json:"self_ready"`
+}
+
+type UIMemberSync struct {
+	MemberID    string `json:"member_id"`
+	DisplayName string `json:"display_name"`
+	DriftMs     int64  `json:"drift_ms"`
+	Endpoint    string `json:"endpoint"`
+	// LastAction is "seek", "soft_rate" or "none".
+	LastAction      string `json:"last_action"`
+	LastActionAgeMs int64  `json:"last_action_age_ms"`
+	// Quality is "good", "fair" or "poor".
+	Quality     string `json:"quality"`
+	ReportAgeMs int64  `json:"report_age_ms"`
+}
+
+type UIMember struct {
+	MemberID    string `json:"member_id"`
+	DisplayName string `json:"display_name"`
+	IsHost      bool   `json:"is_host"`
+	Muted       bool   `json:"muted"`
+	// Self marks this client's own entry.
+	Self bool `json:"self"`
 }
 
 type UIAction struct {
@@ -31,4 +162,37 @@ type UIAction struct {
 	FollowURL   *bool          `json:"follow_url,omitempty"`
 	Config      *config.Config `json:"config,omitempty"`
 	DisplayName string         `json:"display_name,omitempty"`
+	MemberID    string         `json:"member_id,omitempty"`
+	Password    string         `json:"password,omitempty"`
+	JoinPolicy  string         `json:"join_policy,omitempty"`
+	Approve     *bool          `json:"approve,omitempty"`
+	Muted       *bool          `json:"muted,omitempty"`
+	// PauseOnBuffering is used by update_room_settings.
+	PauseOnBuffering *bool `json:"pause_on_buffering,omitempty"`
+	// TimeoutSec is used by start_ready_check; Ready by ready_check_ack.
+	TimeoutSec int64 `json:"timeout_sec,omitempty"`
+	Ready      *bool `json:"ready,omitempty"`
+	// PremiereStartMs (local Unix time in ms), PremiereURL and PremiereTitle
+	// schedule a premiere with create_room.
+	PremiereStartMs int64  `json:"premiere_start_ms,omitempty"`
+	PremiereURL     string `json:"premiere_url,omitempty"`
+	PremiereTitle   string `json:"premiere_title,omitempty"`
+	// ControlMode is used by update_room_settings.
+	ControlMode string `json:"control_mode,omitempty"`
+	// Text is used by send_chat and send_reaction; Emoji by send_reaction.
+	Text  string `json:"text,omitempty"`
+	Emoji string `json:"emoji,omitempty"`
+	// MediaURL and MediaTitle are used by playlist_add; an empty MediaURL
+	// adds what the local player has open. EntryID and Index pick the
+	// entry for playlist_remove, playlist_move and playlist_play.
+	MediaURL   string `json:"media_url,omitempty"`
+	MediaTitle string `json:"media_title,omitempty"`
+	EntryID    string `json:"entry_id,omitempty"`
+	Index      int32  `json:"index,omitempty"`
+	// MembersEditPlaylist, Public, Title and Description are used by
+	// update_room_settings.
+	MembersEditPlaylist *bool   `json:"members_edit_playlist,omitempty"`
+	Public              *bool   `json:"public,omitempty"`
+	Title               *string `json:"title,omitempty"`
+	Description         *string `json:"description,omitempty"`
 }
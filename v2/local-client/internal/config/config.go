@@ -29,6 +29,8 @@ type MPCConfig struct {
 type Config struct {
 	ServerURL                  string    `json:"server_url"`
 	DisplayName                string    `json:"display_name"`
+	ClientIdentity             string    `json:"client_identity"`
+	ResumeToken                string    `json:"resume_token"`
 	ExtListenAddr              string    `json:"ext_listen_addr"`
 	ExtListenPath              string    `json:"ext_listen_path"`
 	ExtIdleTimeoutSec          int64     `json:"ext_idle_timeout_sec"`
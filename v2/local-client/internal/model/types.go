@@ -14,6 +14,8 @@ type PlayerState struct {
     DurationMs int64     `json:"duration_ms"`
     Paused     bool      `json:"paused"`
     Rate       float64   `json:"rate"`
+    Buffering  bool      `json:"buffering"`
+    Ended      bool      `json:"ended"`
     Media      MediaInfo `json:"media"`
     UpdatedAt  time.Time `json:"-"`
 }
@@ -3,6 +3,7 @@ package syncer
 import (
 	"log"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"videowithyou/v2/local-client/internal/adapter"
@@ -19,12 +20,38 @@ type Config struct {
 	SoftRateMaxMS       int64
 }
 
+// Action is what Align did to the local player.
+type Action int
+
+const (
+	ActionNone Action = iota
+	ActionSeek
+	ActionSoftRate
+)
+
+// Result describes one Align pass. OK is false when there was nothing to
+// compare, e.g. no host state or no local player state.
+type Result struct {
+	OK         bool
+	DriftMs    int64
+	PositionMs int64
+	Rate       float64
+	Action     Action
+}
+
 type Core struct {
 	log     *log.Logger
 	adapter adapter.Endpoint
 	cfg     Config
 
 	softUntil time.Time
+
+	// appliedAt is when the player was last changed on our behalf, in Unix
+	// nanoseconds; see MarkApplied.
+	appliedAt atomic.Int64
+	// lastLocal is the player state DetectIntent saw last.
+	lastLocal    model.PlayerState
+	lastLocalSet bool
 }
 
 func NewCore(cfg Config, adapter adapter.Endpoint, logger *log.Logger) *Core {
@@ -46,52 +73,59 @@ func (c *Core) UpdateAdapter(adapter adapter.Endpoint) {
 	c.adapter = adapter
 }
 
-func (c *Core) Align(host *videowithyoupb.HostState, offsetMs int64, localOffsetMs int64) {
+func (c *Core) Align(host *videowithyoupb.HostState, offsetMs int64, localOffsetMs int64) Result {
 	if host == nil || c.adapter == nil {
-		return
+		return Result{}
 	}
 	if host.Media != nil && host.Media.Attrs != nil {
 		if host.Media.Attrs["page_only"] == "1" {
-			return
+			return Result{}
 		}
 	}
 
 	localState, ok := c.adapter.GetState()
 	if !ok {
-		return
-	}
-
-	nowServerMs := time.Now().UnixMilli() + offsetMs
-	base := host.PositionMs + host.OffsetMs + localOffsetMs
-	target := int64(base)
-	if !host.Paused {
-		elapsed := nowServerMs - host.SampleServerTimeMs
-		target = int64(float64(base) + float64(elapsed)*host.Rate)
+		return Result{}
 	}
 
+	target, paused := predictTarget(host, offsetMs, localOffsetMs)
 	drift := target - localState.PositionMs
 	absDrift := int64(math.Abs(float64(drift)))
+	result := Result{
+		OK:         true,
+		DriftMs:    drift,
+		PositionMs: localState.PositionMs,
+		Rate:       localState.Rate,
+	}
+
+	if localState.Buffering {
+		// Seeking a stalled player only makes it refetch; wait for it to
+		// catch up (or for the room to hold for it).
+		return result
+	}
 
 	if absDrift < c.cfg.DeadzoneMS {
 		if time.Now().Before(c.softUntil) {
-			c.applyRate(host.Rate, host.Paused)
+			c.applyRate(host.Rate, paused)
 			c.softUntil = time.Time{}
 		}
-		return
+		return result
 	}
 
 	if absDrift >= c.cfg.HardSeekThresholdMS {
 		c.log.Printf("drift=%dms seek target=%d", drift, target)
+		c.MarkApplied()
 		_ = c.adapter.ApplyState(model.ApplyState{
 			PositionMs: target,
-			Paused:     host.Paused,
+			Paused:     paused,
 			Rate:       host.Rate,
 		})
 		c.softUntil = time.Time{}
-		return
+		result.Action = ActionSeek
+		return result
 	}
 
-	if c.cfg.SoftRateEnabled && absDrift >= c.cfg.SoftRateThresholdMS && !host.Paused {
+	if c.cfg.SoftRateEnabled && absDrift >= c.cfg.SoftRateThresholdMS && !paused {
 		adjusted := host.Rate
 		if drift > 0 {
 			adjusted = host.Rate + c.cfg.SoftRateAdjust
@@ -99,16 +133,58 @@ func (c *Core) Align(host *videowithyoupb.HostState, offsetMs int64, localOffset
 			adjusted = host.Rate - c.cfg.SoftRateAdjust
 		}
 		c.log.Printf("drift=%dms soft-rate=%.3f", drift, adjusted)
+		c.MarkApplied()
 		_ = c.adapter.ApplyState(model.ApplyState{
 			PositionMs: -1,
 			Paused:     false,
 			Rate:       adjusted,
 		})
 		c.softUntil = time.Now().Add(time.Duration(c.cfg.SoftRateMaxMS) * time.Millisecond)
-		return
+		result.Action = ActionSoftRate
+		return result
 	}
 
-	c.applyRate(host.Rate, host.Paused)
+	if localState.Paused != paused {
+		c.MarkApplied()
+	}
+	c.applyRate(host.Rate, paused)
+	return result
+}
+
+// predictTarget returns where the local player should be now for host
+// state, and whether it should be paused.
+func predictTarget(host *videowithyoupb.HostState, offsetMs int64, localOffsetMs int64) (int64, bool) {
+	nowServerMs := time.Now().UnixMilli() + offsetMs
+	base := host.PositionMs + host.OffsetMs + localOffsetMs
+	target := int64(base)
+	paused := host.Paused
+	if !paused {
+		elapsed := nowServerMs - host.SampleServerTimeMs
+		if elapsed < 0 {
+			// A sample in the future is a scheduled start (a premiere):
+			// hold at the start position until it is due.
+			paused = true
+		} else {
+			target = int64(float64(base) + float64(elapsed)*host.Rate)
+		}
+	}
+	return target, paused
+}
+
+// Reset cancels any in-flight soft-rate adjustment and restores rate. It is
+// used when this client stops following, e.g. after being promoted to host.
+func (c *Core) Reset(rate float64) {
+	c.lastLocalSet = false
+	if c.adapter == nil || !time.Now().Before(c.softUntil) {
+		c.softUntil = time.Time{}
+		return
+	}
+	c.softUntil = time.Time{}
+	localState, ok := c.adapter.GetState()
+	if !ok {
+		return
+	}
+	c.applyRate(rate, localState.Paused)
 }
 
 func (c *Core) applyRate(rate float64, paused bool) {
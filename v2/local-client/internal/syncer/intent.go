@@ -0,0 +1,92 @@
+package syncer
+
+import (
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// IntentAction is a playback change the user made on the local player.
+type IntentAction int
+
+const (
+	IntentNone IntentAction = iota
+	IntentPlay
+	IntentPause
+	IntentSeek
+)
+
+// Intent is a local change to pass on to the host. PositionMs is on the
+// host's timeline.
+type Intent struct {
+	Action     IntentAction
+	PositionMs int64
+}
+
+// appliedQuiet is how long after we changed the player ourselves that its
+// changes are not taken for the user's.
+const appliedQuiet = 1500 * time.Millisecond
+
+// MarkApplied records that the player is being changed on our behalf, e.g.
+// by Align or a group hold, so DetectIntent ignores the result.
+func (c *Core) MarkApplied() {
+	c.appliedAt.Store(time.Now().UnixNano())
+}
+
+// DetectIntent compares the local player with its state at the previous
+// call and reports a play, pause or seek that neither Align nor the host
+// caused. Call it once per tick, before Align.
+func (c *Core) DetectIntent(host *videowithyoupb.HostState, offsetMs int64, localOffsetMs int64) (Intent, bool) {
+	if host == nil || c.adapter == nil {
+		return Intent{}, false
+	}
+	local, ok := c.adapter.GetState()
+	if !ok {
+		return Intent{}, false
+	}
+	prev, hadPrev := c.lastLocal, c.lastLocalSet
+	c.lastLocal, c.lastLocalSet = local, true
+	if !hadPrev || !local.UpdatedAt.After(prev.UpdatedAt) || local.Buffering {
+		return Intent{}, false
+	}
+	if local.Media.URL != prev.Media.URL {
+		// A new page starts wherever it starts; that is not a seek.
+		return Intent{}, false
+	}
+	if time.Since(time.Unix(0, c.appliedAt.Load())) < appliedQuiet {
+		return Intent{}, false
+	}
+
+	target, paused := predictTarget(host, offsetMs, localOffsetMs)
+	intent := Intent{PositionMs: local.PositionMs - host.OffsetMs - localOffsetMs}
+	if intent.PositionMs < 0 {
+		intent.PositionMs = 0
+	}
+	if local.Paused != prev.Paused {
+		if local.Paused == paused {
+			return Intent{}, false
+		}
+		intent.Action = IntentPlay
+		if local.Paused {
+			intent.Action = IntentPause
+		}
+		return intent, true
+	}
+
+	expected := prev.PositionMs
+	if !prev.Paused {
+		expected += int64(float64(local.UpdatedAt.Sub(prev.UpdatedAt).Milliseconds()) * prev.Rate)
+	}
+	if absMs(local.PositionMs-expected) < c.cfg.HardSeekThresholdMS || absMs(local.PositionMs-target) < c.cfg.HardSeekThresholdMS {
+		return Intent{}, false
+	}
+	intent.Action = IntentSeek
+	return intent, true
+}
+
+func absMs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,200 @@
+package ws
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The HTTP fallback reaches the server through networks that block
+// websockets: a server-sent event stream at <path>/sse brings envelopes
+// down and each envelope goes up as a POST naming the stream's client id.
+
+// helloHeader carries the base64 ClientHello opening the event stream.
+const helloHeader = "X-VideoWithYou-Hello"
+
+// maxEventBytes bounds one event line: a 2 MiB envelope in base64.
+const maxEventBytes = 3 << 20
+
+var postClient = &http.Client{Timeout: writeWait}
+
+// fallbackURL returns the HTTP fallback endpoint of the server at wsURL, or
+// "" if wsURL is not a websocket URL.
+func fallbackURL(wsURL string) string {
+	u, err := url.Parse(wsURL)
+	if err != nil {
+		return ""
+	}
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	default:
+		return ""
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/sse"
+	u.RawQuery = ""
+	return u.String()
+}
+
+// httpSession is what POSTs need from the stream: the client id and resume
+// token from the ServerHello.
+type httpSession struct {
+	once     sync.Once
+	ready    chan struct{}
+	clientID string
+	token    string
+}
+
+// runHTTP is runWS for the HTTP fallback.
+func (c *Client) runHTTP(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.fallbackURL, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if c.hello != nil {
+		// The hello holds the resume token, so it goes in a header rather
+		// than the URL.
+		payload, err := proto.Marshal(c.hello())
+		if err != nil {
+			return false, err
+		}
+		req.Header.Set(helloHeader, base64.StdEncoding.EncodeToString(payload))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("http fallback: %s", resp.Status)
+	}
+	c.established(c.fallbackURL)
+
+	session := &httpSession{ready: make(chan struct{})}
+	errCh := make(chan error, 2)
+	go c.readEvents(resp.Body, session, cancel, errCh)
+	go c.postLoop(ctx, session, errCh)
+
+	select {
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case err := <-errCh:
+		return true, err
+	}
+}
+
+// readEvents is readLoop for the event stream. The server sends a ping
+// comment every pingPeriod; a stream quiet for pongWait is cut with stop.
+func (c *Client) readEvents(body io.Reader, session *httpSession, stop func(), errCh chan<- error) {
+	watchdog := time.AfterFunc(pongWait, stop)
+	defer watchdog.Stop()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64<<10), maxEventBytes)
+	var event, data string
+	for scanner.Scan() {
+		watchdog.Reset(pongWait)
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, ":"):
+			if c.onActivity != nil {
+				c.onActivity()
+			}
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " ")
+		case line == "":
+			if event == "close" {
+				errCh <- fmt.Errorf("closed by server: %s", data)
+				return
+			}
+			if data != "" {
+				c.receiveEvent(data, session)
+			}
+			event, data = "", ""
+		}
+	}
+	err := scanner.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	errCh <- err
+}
+
+func (c *Client) receiveEvent(data string, session *httpSession) {
+	payload, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		c.log.Printf("ws bad event: %v", err)
+		return
+	}
+	env := c.receive(payload)
+	if hello := env.GetServerHello(); hello != nil {
+		session.once.Do(func() {
+			session.clientID = hello.ClientId
+			session.token = hello.ResumeToken
+			close(session.ready)
+		})
+	}
+}
+
+// postLoop is writeLoop for the HTTP fallback. It waits for the ServerHello
+// to learn where to POST.
+func (c *Client) postLoop(ctx context.Context, session *httpSession, errCh chan<- error) {
+	select {
+	case <-session.ready:
+	case <-ctx.Done():
+		return
+	}
+	target := c.fallbackURL + "?client_id=" + url.QueryEscape(session.clientID)
+
+	for {
+		select {
+		case payload := <-c.send:
+			if err := c.post(ctx, target, session.token, payload); err != nil {
+				errCh <- err
+				return
+			}
+			if c.onActivity != nil {
+				c.onActivity()
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Client) post(ctx context.Context, target, token string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := postClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.New("http fallback post: " + resp.Status)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package ws
 import (
 	"context"
 	"log"
+	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -16,6 +18,14 @@ const (
 	pongWait       = 30 * time.Second
 	pingPeriod     = 15 * time.Second
 	reconnectDelay = 2 * time.Second
+	// maxReconnectDelay caps the backoff between failed reconnects.
+	maxReconnectDelay = 30 * time.Second
+	// upgradeRetryDelay spaces out reconnects after the server turned the
+	// client away as too old; it will only accept it again once upgraded.
+	upgradeRetryDelay = 10 * time.Minute
+	// fallbackAfter is how many websocket dials in a row may fail before the
+	// client tries the HTTP fallback.
+	fallbackAfter = 3
 )
 
 type Client struct {
@@ -23,9 +33,19 @@ type Client struct {
 	log        *log.Logger
 	incoming   chan *videowithyoupb.Envelope
 	send       chan []byte
-	onConnect  func(*websocket.Conn) error
+	hello      func() *videowithyoupb.Envelope
 	onStatus   func(bool)
 	onActivity func()
+	// fallbackURL is the server's HTTP fallback endpoint, derived from url;
+	// empty if url does not name one.
+	fallbackURL string
+	connected   bool
+
+	mu sync.Mutex
+	// retryAfter is the server's reconnect hint from a ServerNotice; it
+	// replaces the backoff for the next reconnect only.
+	retryAfter time.Duration
+	backoff    time.Duration
 }
 
 func NewClient(url string, logger *log.Logger) *Client {
@@ -33,15 +53,19 @@ func NewClient(url string, logger *log.Logger) *Client {
 		logger = log.Default()
 	}
 	return &Client{
-		url:      url,
-		log:      logger,
-		incoming: make(chan *videowithyoupb.Envelope, 128),
-		send:     make(chan []byte, 128),
+		url:         url,
+		log:         logger,
+		incoming:    make(chan *videowithyoupb.Envelope, 128),
+		send:        make(chan []byte, 128),
+		backoff:     reconnectDelay,
+		fallbackURL: fallbackURL(url),
 	}
 }
 
-func (c *Client) SetOnConnect(fn func(*websocket.Conn) error) {
-	c.onConnect = fn
+// SetHello sets the function building the ClientHello sent first on every
+// connection.
+func (c *Client) SetHello(fn func() *videowithyoupb.Envelope) {
+	c.hello = fn
 }
 
 func (c *Client) SetOnStatus(fn func(bool)) {
@@ -69,74 +93,152 @@ func (c *Client) Send(env *videowithyoupb.Envelope) {
 	}
 }
 
+// Start keeps the client connected until ctx ends. After fallbackAfter
+// failed websocket dials in a row it switches to the HTTP fallback, and
+// returns to websockets once that fails to connect too.
 func (c *Client) Start(ctx context.Context) {
-	connected := false
+	failures := 0
+	useHTTP := false
 	for {
 		select {
 		case <-ctx.Done():
-			if connected && c.onStatus != nil {
-				c.onStatus(false)
-			}
+			c.setConnected(false)
 			return
 		default:
 		}
 
-		conn, _, err := websocket.DefaultDialer.Dial(c.url, nil)
-		if err != nil {
-			if connected && c.onStatus != nil {
-				connected = false
-				c.onStatus(false)
-			}
-			c.log.Printf("ws connect failed: %v", err)
-			time.Sleep(reconnectDelay)
-			continue
+		var established bool
+		var err error
+		if useHTTP {
+			established, err = c.runHTTP(ctx)
+		} else {
+			established, err = c.runWS(ctx)
+		}
+		if ctx.Err() != nil {
+			c.setConnected(false)
+			return
 		}
+		c.setConnected(false)
 
-		conn.SetReadLimit(2 << 20)
-		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
-		conn.SetPongHandler(func(string) error {
-			_ = conn.SetReadDeadline(time.Now().Add(pongWait))
-			return nil
-		})
-
-		if c.onConnect != nil {
-			if err := c.onConnect(conn); err != nil {
-				_ = conn.Close()
-				time.Sleep(reconnectDelay)
+		switch {
+		case established:
+			failures = 0
+			c.log.Printf("ws disconnected, retrying: %v", err)
+		case useHTTP:
+			c.log.Printf("http fallback connect failed: %v", err)
+			useHTTP = false
+		default:
+			c.log.Printf("ws connect failed: %v", err)
+			failures++
+			if failures >= fallbackAfter && c.fallbackURL != "" {
+				failures = 0
+				useHTTP = true
+				c.log.Printf("ws failed %d times, trying http fallback", fallbackAfter)
 				continue
 			}
 		}
-		if c.onActivity != nil {
-			c.onActivity()
+		c.waitReconnect(ctx)
+	}
+}
+
+// runWS holds one websocket connection until it fails or ctx ends. It
+// reports whether the connection was established.
+func (c *Client) runWS(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	conn.SetReadLimit(2 << 20)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	if c.hello != nil {
+		payload, err := proto.Marshal(c.hello())
+		if err != nil {
+			return false, err
 		}
-		c.log.Printf("ws connected %s", c.url)
-		if !connected && c.onStatus != nil {
-			connected = true
-			c.onStatus(true)
+		_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+			return false, err
 		}
+	}
+	c.established(c.url)
 
-		errCh := make(chan error, 2)
-		go c.readLoop(conn, errCh)
-		go c.writeLoop(conn, errCh)
+	// done stops writeLoop, so it leaves queued payloads to the next
+	// connection.
+	done := make(chan struct{})
+	defer close(done)
+	errCh := make(chan error, 2)
+	go c.readLoop(conn, errCh)
+	go c.writeLoop(conn, errCh, done)
 
-		select {
-		case <-ctx.Done():
-			_ = conn.Close()
-			if connected && c.onStatus != nil {
-				connected = false
-				c.onStatus(false)
-			}
-			return
-		case <-errCh:
-			_ = conn.Close()
-			c.log.Printf("ws disconnected, retrying")
-			if connected && c.onStatus != nil {
-				connected = false
-				c.onStatus(false)
-			}
-			time.Sleep(reconnectDelay)
+	select {
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case err := <-errCh:
+		return true, err
+	}
+}
+
+// established records a new connection to target.
+func (c *Client) established(target string) {
+	c.mu.Lock()
+	c.backoff = reconnectDelay
+	c.mu.Unlock()
+	if c.onActivity != nil {
+		c.onActivity()
+	}
+	c.log.Printf("ws connected %s", target)
+	c.setConnected(true)
+}
+
+func (c *Client) setConnected(connected bool) {
+	if c.connected == connected {
+		return
+	}
+	c.connected = connected
+	if c.onStatus != nil {
+		c.onStatus(connected)
+	}
+}
+
+// DelayReconnect makes the next reconnect wait d (plus jitter so clients of
+// a restarting server do not all return at once).
+func (c *Client) DelayReconnect(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.mu.Lock()
+	c.retryAfter = d
+	c.mu.Unlock()
+}
+
+func (c *Client) waitReconnect(ctx context.Context) {
+	c.mu.Lock()
+	delay := c.backoff
+	if c.retryAfter > 0 {
+		delay = c.retryAfter + time.Duration(rand.Int63n(int64(c.retryAfter/4)+1))
+		c.retryAfter = 0
+	} else {
+		c.backoff *= 2
+		if c.backoff > maxReconnectDelay {
+			c.backoff = maxReconnectDelay
 		}
 	}
+	c.mu.Unlock()
+
+	c.log.Printf("ws reconnecting in %s", delay.Round(time.Millisecond))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
 }
 
 func (c *Client) readLoop(conn *websocket.Conn, errCh chan<- error) {
@@ -149,23 +251,36 @@ func (c *Client) readLoop(conn *websocket.Conn, errCh chan<- error) {
 		if msgType != websocket.BinaryMessage {
 			continue
 		}
-		if c.onActivity != nil {
-			c.onActivity()
-		}
-		env := &videowithyoupb.Envelope{}
-		if err := proto.Unmarshal(data, env); err != nil {
-			c.log.Printf("ws bad envelope: %v", err)
-			continue
-		}
-		select {
-		case c.incoming <- env:
-		default:
-			c.log.Printf("ws incoming queue full")
-		}
+		c.receive(data)
 	}
 }
 
-func (c *Client) writeLoop(conn *websocket.Conn, errCh chan<- error) {
+// receive hands a message from the server, of either transport, to
+// Incoming.
+func (c *Client) receive(data []byte) *videowithyoupb.Envelope {
+	if c.onActivity != nil {
+		c.onActivity()
+	}
+	env := &videowithyoupb.Envelope{}
+	if err := proto.Unmarshal(data, env); err != nil {
+		c.log.Printf("ws bad envelope: %v", err)
+		return nil
+	}
+	if notice := env.GetServerNotice(); notice != nil && notice.ReconnectAfterMs > 0 {
+		c.DelayReconnect(time.Duration(notice.ReconnectAfterMs) * time.Millisecond)
+	}
+	if env.GetErrorResp().GetCode() == videowithyoupb.ErrorCode_ERROR_CODE_UPGRADE_REQUIRED {
+		c.DelayReconnect(upgradeRetryDelay)
+	}
+	select {
+	case c.incoming <- env:
+	default:
+		c.log.Printf("ws incoming queue full")
+	}
+	return env
+}
+
+func (c *Client) writeLoop(conn *websocket.Conn, errCh chan<- error, done <-chan struct{}) {
 	ticker := time.NewTicker(pingPeriod)
 	defer ticker.Stop()
 
@@ -189,6 +304,8 @@ func (c *Client) writeLoop(conn *websocket.Conn, errCh chan<- error) {
 			if c.onActivity != nil {
 				c.onActivity()
 			}
+		case <-done:
+			return
 		}
 	}
 }
@@ -20,6 +20,369 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type JoinPolicy int32
+
+const (
+	JoinPolicy_JOIN_POLICY_OPEN     JoinPolicy = 0
+	JoinPolicy_JOIN_POLICY_PASSWORD JoinPolicy = 1
+	// Joiners wait until the host accepts them with a JoinDecision.
+	JoinPolicy_JOIN_POLICY_APPROVAL JoinPolicy = 2
+)
+
+// Enum value maps for JoinPolicy.
+var (
+	JoinPolicy_name = map[int32]string{
+		0: "JOIN_POLICY_OPEN",
+		1: "JOIN_POLICY_PASSWORD",
+		2: "JOIN_POLICY_APPROVAL",
+	}
+	JoinPolicy_value = map[string]int32{
+		"JOIN_POLICY_OPEN":     0,
+		"JOIN_POLICY_PASSWORD": 1,
+		"JOIN_POLICY_APPROVAL": 2,
+	}
+)
+
+func (x JoinPolicy) Enum() *JoinPolicy {
+	p := new(JoinPolicy)
+	*p = x
+	return p
+}
+
+func (x JoinPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[0].Descriptor()
+}
+
+func (JoinPolicy) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[0]
+}
+
+func (x JoinPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinPolicy.Descriptor instead.
+func (JoinPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{0}
+}
+
+// ControlMode says who may play, pause and seek for the room.
+type ControlMode int32
+
+const (
+	ControlMode_CONTROL_MODE_HOST_ONLY ControlMode = 0
+	// Followers' ControlIntents are applied by the host right away.
+	ControlMode_CONTROL_MODE_SHARED ControlMode = 1
+	// The host approves each ControlIntent first.
+	ControlMode_CONTROL_MODE_REQUEST ControlMode = 2
+)
+
+// Enum value maps for ControlMode.
+var (
+	ControlMode_name = map[int32]string{
+		0: "CONTROL_MODE_HOST_ONLY",
+		1: "CONTROL_MODE_SHARED",
+		2: "CONTROL_MODE_REQUEST",
+	}
+	ControlMode_value = map[string]int32{
+		"CONTROL_MODE_HOST_ONLY": 0,
+		"CONTROL_MODE_SHARED":    1,
+		"CONTROL_MODE_REQUEST":   2,
+	}
+)
+
+func (x ControlMode) Enum() *ControlMode {
+	p := new(ControlMode)
+	*p = x
+	return p
+}
+
+func (x ControlMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[1].Descriptor()
+}
+
+func (ControlMode) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[1]
+}
+
+func (x ControlMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlMode.Descriptor instead.
+func (ControlMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{1}
+}
+
+type ControlAction int32
+
+const (
+	ControlAction_CONTROL_ACTION_UNSPECIFIED ControlAction = 0
+	ControlAction_CONTROL_ACTION_PLAY        ControlAction = 1
+	ControlAction_CONTROL_ACTION_PAUSE       ControlAction = 2
+	ControlAction_CONTROL_ACTION_SEEK        ControlAction = 3
+)
+
+// Enum value maps for ControlAction.
+var (
+	ControlAction_name = map[int32]string{
+		0: "CONTROL_ACTION_UNSPECIFIED",
+		1: "CONTROL_ACTION_PLAY",
+		2: "CONTROL_ACTION_PAUSE",
+		3: "CONTROL_ACTION_SEEK",
+	}
+	ControlAction_value = map[string]int32{
+		"CONTROL_ACTION_UNSPECIFIED": 0,
+		"CONTROL_ACTION_PLAY":        1,
+		"CONTROL_ACTION_PAUSE":       2,
+		"CONTROL_ACTION_SEEK":        3,
+	}
+)
+
+func (x ControlAction) Enum() *ControlAction {
+	p := new(ControlAction)
+	*p = x
+	return p
+}
+
+func (x ControlAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[2].Descriptor()
+}
+
+func (ControlAction) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[2]
+}
+
+func (x ControlAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlAction.Descriptor instead.
+func (ControlAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{2}
+}
+
+type PlaylistOp int32
+
+const (
+	PlaylistOp_PLAYLIST_OP_UNSPECIFIED PlaylistOp = 0
+	// Append media.
+	PlaylistOp_PLAYLIST_OP_ADD PlaylistOp = 1
+	// Remove entry_id.
+	PlaylistOp_PLAYLIST_OP_REMOVE PlaylistOp = 2
+	// Move entry_id to index.
+	PlaylistOp_PLAYLIST_OP_MOVE PlaylistOp = 3
+	// Host only: take entry_id out of the queue and play it now.
+	PlaylistOp_PLAYLIST_OP_PLAY PlaylistOp = 4
+	// Host only: the current entry, entry_id (empty if nothing from the queue
+	// was playing), finished; play the first queued entry. A stale entry_id
+	// is ignored, so repeated reports advance once.
+	PlaylistOp_PLAYLIST_OP_ADVANCE PlaylistOp = 5
+)
+
+// Enum value maps for PlaylistOp.
+var (
+	PlaylistOp_name = map[int32]string{
+		0: "PLAYLIST_OP_UNSPECIFIED",
+		1: "PLAYLIST_OP_ADD",
+		2: "PLAYLIST_OP_REMOVE",
+		3: "PLAYLIST_OP_MOVE",
+		4: "PLAYLIST_OP_PLAY",
+		5: "PLAYLIST_OP_ADVANCE",
+	}
+	PlaylistOp_value = map[string]int32{
+		"PLAYLIST_OP_UNSPECIFIED": 0,
+		"PLAYLIST_OP_ADD":         1,
+		"PLAYLIST_OP_REMOVE":      2,
+		"PLAYLIST_OP_MOVE":        3,
+		"PLAYLIST_OP_PLAY":        4,
+		"PLAYLIST_OP_ADVANCE":     5,
+	}
+)
+
+func (x PlaylistOp) Enum() *PlaylistOp {
+	p := new(PlaylistOp)
+	*p = x
+	return p
+}
+
+func (x PlaylistOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaylistOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[3].Descriptor()
+}
+
+func (PlaylistOp) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[3]
+}
+
+func (x PlaylistOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaylistOp.Descriptor instead.
+func (PlaylistOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{3}
+}
+
+type SyncAction int32
+
+const (
+	SyncAction_SYNC_ACTION_NONE      SyncAction = 0
+	SyncAction_SYNC_ACTION_SEEK      SyncAction = 1
+	SyncAction_SYNC_ACTION_SOFT_RATE SyncAction = 2
+)
+
+// Enum value maps for SyncAction.
+var (
+	SyncAction_name = map[int32]string{
+		0: "SYNC_ACTION_NONE",
+		1: "SYNC_ACTION_SEEK",
+		2: "SYNC_ACTION_SOFT_RATE",
+	}
+	SyncAction_value = map[string]int32{
+		"SYNC_ACTION_NONE":      0,
+		"SYNC_ACTION_SEEK":      1,
+		"SYNC_ACTION_SOFT_RATE": 2,
+	}
+)
+
+func (x SyncAction) Enum() *SyncAction {
+	p := new(SyncAction)
+	*p = x
+	return p
+}
+
+func (x SyncAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[4].Descriptor()
+}
+
+func (SyncAction) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[4]
+}
+
+func (x SyncAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncAction.Descriptor instead.
+func (SyncAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{4}
+}
+
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED           ErrorCode = 0
+	ErrorCode_ERROR_CODE_ROOM_NOT_FOUND        ErrorCode = 1
+	ErrorCode_ERROR_CODE_ROOM_CLOSED_HOST_LEFT ErrorCode = 2
+	ErrorCode_ERROR_CODE_ROOM_CLOSED_IDLE      ErrorCode = 3
+	ErrorCode_ERROR_CODE_NOT_HOST              ErrorCode = 4
+	ErrorCode_ERROR_CODE_RATE_LIMITED          ErrorCode = 5
+	ErrorCode_ERROR_CODE_NOT_IN_ROOM           ErrorCode = 6
+	ErrorCode_ERROR_CODE_MEMBER_NOT_FOUND      ErrorCode = 7
+	ErrorCode_ERROR_CODE_INVALID_REQUEST       ErrorCode = 8
+	ErrorCode_ERROR_CODE_WRONG_PASSWORD        ErrorCode = 9
+	ErrorCode_ERROR_CODE_JOIN_REJECTED         ErrorCode = 10
+	ErrorCode_ERROR_CODE_KICKED                ErrorCode = 11
+	ErrorCode_ERROR_CODE_BANNED                ErrorCode = 12
+	ErrorCode_ERROR_CODE_MUTED                 ErrorCode = 13
+	ErrorCode_ERROR_CODE_ROOM_CLOSED_ADMIN     ErrorCode = 14
+	// The host's sample_server_time_ms is far from the server clock; the host
+	// should rerun time sync.
+	ErrorCode_ERROR_CODE_CLOCK_SKEW ErrorCode = 15
+	// The client's protocol_version is older than the server accepts; the
+	// server closes the connection after sending it.
+	ErrorCode_ERROR_CODE_UPGRADE_REQUIRED ErrorCode = 16
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "ERROR_CODE_UNSPECIFIED",
+		1:  "ERROR_CODE_ROOM_NOT_FOUND",
+		2:  "ERROR_CODE_ROOM_CLOSED_HOST_LEFT",
+		3:  "ERROR_CODE_ROOM_CLOSED_IDLE",
+		4:  "ERROR_CODE_NOT_HOST",
+		5:  "ERROR_CODE_RATE_LIMITED",
+		6:  "ERROR_CODE_NOT_IN_ROOM",
+		7:  "ERROR_CODE_MEMBER_NOT_FOUND",
+		8:  "ERROR_CODE_INVALID_REQUEST",
+		9:  "ERROR_CODE_WRONG_PASSWORD",
+		10: "ERROR_CODE_JOIN_REJECTED",
+		11: "ERROR_CODE_KICKED",
+		12: "ERROR_CODE_BANNED",
+		13: "ERROR_CODE_MUTED",
+		14: "ERROR_CODE_ROOM_CLOSED_ADMIN",
+		15: "ERROR_CODE_CLOCK_SKEW",
+		16: "ERROR_CODE_UPGRADE_REQUIRED",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":           0,
+		"ERROR_CODE_ROOM_NOT_FOUND":        1,
+		"ERROR_CODE_ROOM_CLOSED_HOST_LEFT": 2,
+		"ERROR_CODE_ROOM_CLOSED_IDLE":      3,
+		"ERROR_CODE_NOT_HOST":              4,
+		"ERROR_CODE_RATE_LIMITED":          5,
+		"ERROR_CODE_NOT_IN_ROOM":           6,
+		"ERROR_CODE_MEMBER_NOT_FOUND":      7,
+		"ERROR_CODE_INVALID_REQUEST":       8,
+		"ERROR_CODE_WRONG_PASSWORD":        9,
+		"ERROR_CODE_JOIN_REJECTED":         10,
+		"ERROR_CODE_KICKED":                11,
+		"ERROR_CODE_BANNED":                12,
+		"ERROR_CODE_MUTED":                 13,
+		"ERROR_CODE_ROOM_CLOSED_ADMIN":     14,
+		"ERROR_CODE_CLOCK_SKEW":            15,
+		"ERROR_CODE_UPGRADE_REQUIRED":      16,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_videowithyou_proto_enumTypes[5].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_videowithyou_proto_enumTypes[5]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{5}
+}
+
 type Envelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -41,6 +404,29 @@ type Envelope struct {
 	//	*Envelope_TimeSyncResp
 	//	*Envelope_ErrorResp
 	//	*Envelope_MemberStatus
+	//	*Envelope_TransferHostReq
+	//	*Envelope_JoinPendingResp
+	//	*Envelope_JoinRequestNotice
+	//	*Envelope_JoinDecision
+	//	*Envelope_KickMemberReq
+	//	*Envelope_BanMemberReq
+	//	*Envelope_MuteMemberReq
+	//	*Envelope_ServerNotice
+	//	*Envelope_FollowerReport
+	//	*Envelope_SyncHealth
+	//	*Envelope_UpdateRoomSettingsReq
+	//	*Envelope_GroupPlayback
+	//	*Envelope_ReadyCheckReq
+	//	*Envelope_ReadyCheckAck
+	//	*Envelope_ReadyCheck
+	//	*Envelope_ControlIntent
+	//	*Envelope_ControlDecision
+	//	*Envelope_ChatMessage
+	//	*Envelope_Reaction
+	//	*Envelope_ReactionBatch
+	//	*Envelope_PlaylistUpdateReq
+	//	*Envelope_ListRoomsReq
+	//	*Envelope_ListRoomsResp
 	Payload isEnvelope_Payload `protobuf_oneof:"payload"`
 }
 
@@ -181,6 +567,167 @@ func (x *Envelope) GetMemberStatus() *MemberStatus {
 	return nil
 }
 
+func (x *Envelope) GetTransferHostReq() *TransferHostReq {
+	if x, ok := x.GetPayload().(*Envelope_TransferHostReq); ok {
+		return x.TransferHostReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetJoinPendingResp() *JoinPendingResp {
+	if x, ok := x.GetPayload().(*Envelope_JoinPendingResp); ok {
+		return x.JoinPendingResp
+	}
+	return nil
+}
+
+func (x *Envelope) GetJoinRequestNotice() *JoinRequestNotice {
+	if x, ok := x.GetPayload().(*Envelope_JoinRequestNotice); ok {
+		return x.JoinRequestNotice
+	}
+	return nil
+}
+
+func (x *Envelope) GetJoinDecision() *JoinDecision {
+	if x, ok := x.GetPayload().(*Envelope_JoinDecision); ok {
+		return x.JoinDecision
+	}
+	return nil
+}
+
+func (x *Envelope) GetKickMemberReq() *KickMemberReq {
+	if x, ok := x.GetPayload().(*Envelope_KickMemberReq); ok {
+		return x.KickMemberReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetBanMemberReq() *BanMemberReq {
+	if x, ok := x.GetPayload().(*Envelope_BanMemberReq); ok {
+		return x.BanMemberReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetMuteMemberReq() *MuteMemberReq {
+	if x, ok := x.GetPayload().(*Envelope_MuteMemberReq); ok {
+		return x.MuteMemberReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetServerNotice() *ServerNotice {
+	if x, ok := x.GetPayload().(*Envelope_ServerNotice); ok {
+		return x.ServerNotice
+	}
+	return nil
+}
+
+func (x *Envelope) GetFollowerReport() *FollowerReport {
+	if x, ok := x.GetPayload().(*Envelope_FollowerReport); ok {
+		return x.FollowerReport
+	}
+	return nil
+}
+
+func (x *Envelope) GetSyncHealth() *SyncHealth {
+	if x, ok := x.GetPayload().(*Envelope_SyncHealth); ok {
+		return x.SyncHealth
+	}
+	return nil
+}
+
+func (x *Envelope) GetUpdateRoomSettingsReq() *UpdateRoomSettingsReq {
+	if x, ok := x.GetPayload().(*Envelope_UpdateRoomSettingsReq); ok {
+		return x.UpdateRoomSettingsReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetGroupPlayback() *GroupPlayback {
+	if x, ok := x.GetPayload().(*Envelope_GroupPlayback); ok {
+		return x.GroupPlayback
+	}
+	return nil
+}
+
+func (x *Envelope) GetReadyCheckReq() *ReadyCheckReq {
+	if x, ok := x.GetPayload().(*Envelope_ReadyCheckReq); ok {
+		return x.ReadyCheckReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetReadyCheckAck() *ReadyCheckAck {
+	if x, ok := x.GetPayload().(*Envelope_ReadyCheckAck); ok {
+		return x.ReadyCheckAck
+	}
+	return nil
+}
+
+func (x *Envelope) GetReadyCheck() *ReadyCheck {
+	if x, ok := x.GetPayload().(*Envelope_ReadyCheck); ok {
+		return x.ReadyCheck
+	}
+	return nil
+}
+
+func (x *Envelope) GetControlIntent() *ControlIntent {
+	if x, ok := x.GetPayload().(*Envelope_ControlIntent); ok {
+		return x.ControlIntent
+	}
+	return nil
+}
+
+func (x *Envelope) GetControlDecision() *ControlDecision {
+	if x, ok := x.GetPayload().(*Envelope_ControlDecision); ok {
+		return x.ControlDecision
+	}
+	return nil
+}
+
+func (x *Envelope) GetChatMessage() *ChatMessage {
+	if x, ok := x.GetPayload().(*Envelope_ChatMessage); ok {
+		return x.ChatMessage
+	}
+	return nil
+}
+
+func (x *Envelope) GetReaction() *Reaction {
+	if x, ok := x.GetPayload().(*Envelope_Reaction); ok {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *Envelope) GetReactionBatch() *ReactionBatch {
+	if x, ok := x.GetPayload().(*Envelope_ReactionBatch); ok {
+		return x.ReactionBatch
+	}
+	return nil
+}
+
+func (x *Envelope) GetPlaylistUpdateReq() *PlaylistUpdateReq {
+	if x, ok := x.GetPayload().(*Envelope_PlaylistUpdateReq); ok {
+		return x.PlaylistUpdateReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetListRoomsReq() *ListRoomsReq {
+	if x, ok := x.GetPayload().(*Envelope_ListRoomsReq); ok {
+		return x.ListRoomsReq
+	}
+	return nil
+}
+
+func (x *Envelope) GetListRoomsResp() *ListRoomsResp {
+	if x, ok := x.GetPayload().(*Envelope_ListRoomsResp); ok {
+		return x.ListRoomsResp
+	}
+	return nil
+}
+
 type isEnvelope_Payload interface {
 	isEnvelope_Payload()
 }
@@ -241,41 +788,192 @@ type Envelope_MemberStatus struct {
 	MemberStatus *MemberStatus `protobuf:"bytes,14,opt,name=member_status,json=memberStatus,proto3,oneof"`
 }
 
-func (*Envelope_ClientHello) isEnvelope_Payload() {}
+type Envelope_TransferHostReq struct {
+	TransferHostReq *TransferHostReq `protobuf:"bytes,15,opt,name=transfer_host_req,json=transferHostReq,proto3,oneof"`
+}
 
-func (*Envelope_ServerHello) isEnvelope_Payload() {}
+type Envelope_JoinPendingResp struct {
+	JoinPendingResp *JoinPendingResp `protobuf:"bytes,16,opt,name=join_pending_resp,json=joinPendingResp,proto3,oneof"`
+}
 
-func (*Envelope_CreateRoomReq) isEnvelope_Payload() {}
+type Envelope_JoinRequestNotice struct {
+	JoinRequestNotice *JoinRequestNotice `protobuf:"bytes,17,opt,name=join_request_notice,json=joinRequestNotice,proto3,oneof"`
+}
 
-func (*Envelope_CreateRoomResp) isEnvelope_Payload() {}
+type Envelope_JoinDecision struct {
+	JoinDecision *JoinDecision `protobuf:"bytes,18,opt,name=join_decision,json=joinDecision,proto3,oneof"`
+}
 
-func (*Envelope_JoinRoomReq) isEnvelope_Payload() {}
+type Envelope_KickMemberReq struct {
+	KickMemberReq *KickMemberReq `protobuf:"bytes,19,opt,name=kick_member_req,json=kickMemberReq,proto3,oneof"`
+}
 
-func (*Envelope_JoinRoomResp) isEnvelope_Payload() {}
+type Envelope_BanMemberReq struct {
+	BanMemberReq *BanMemberReq `protobuf:"bytes,20,opt,name=ban_member_req,json=banMemberReq,proto3,oneof"`
+}
 
-func (*Envelope_LeaveRoomReq) isEnvelope_Payload() {}
+type Envelope_MuteMemberReq struct {
+	MuteMemberReq *MuteMemberReq `protobuf:"bytes,21,opt,name=mute_member_req,json=muteMemberReq,proto3,oneof"`
+}
 
-func (*Envelope_RoomSnapshot) isEnvelope_Payload() {}
+type Envelope_ServerNotice struct {
+	ServerNotice *ServerNotice `protobuf:"bytes,22,opt,name=server_notice,json=serverNotice,proto3,oneof"`
+}
 
-func (*Envelope_HostState) isEnvelope_Payload() {}
+type Envelope_FollowerReport struct {
+	FollowerReport *FollowerReport `protobuf:"bytes,23,opt,name=follower_report,json=followerReport,proto3,oneof"`
+}
 
-func (*Envelope_BroadcastState) isEnvelope_Payload() {}
+type Envelope_SyncHealth struct {
+	SyncHealth *SyncHealth `protobuf:"bytes,24,opt,name=sync_health,json=syncHealth,proto3,oneof"`
+}
 
-func (*Envelope_TimeSyncReq) isEnvelope_Payload() {}
+type Envelope_UpdateRoomSettingsReq struct {
+	UpdateRoomSettingsReq *UpdateRoomSettingsReq `protobuf:"bytes,25,opt,name=update_room_settings_req,json=updateRoomSettingsReq,proto3,oneof"`
+}
 
-func (*Envelope_TimeSyncResp) isEnvelope_Payload() {}
+type Envelope_GroupPlayback struct {
+	GroupPlayback *GroupPlayback `protobuf:"bytes,26,opt,name=group_playback,json=groupPlayback,proto3,oneof"`
+}
 
-func (*Envelope_ErrorResp) isEnvelope_Payload() {}
+type Envelope_ReadyCheckReq struct {
+	ReadyCheckReq *ReadyCheckReq `protobuf:"bytes,27,opt,name=ready_check_req,json=readyCheckReq,proto3,oneof"`
+}
 
-func (*Envelope_MemberStatus) isEnvelope_Payload() {}
+type Envelope_ReadyCheckAck struct {
+	ReadyCheckAck *ReadyCheckAck `protobuf:"bytes,28,opt,name=ready_check_ack,json=readyCheckAck,proto3,oneof"`
+}
 
-type ClientHello struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Envelope_ReadyCheck struct {
+	ReadyCheck *ReadyCheck `protobuf:"bytes,29,opt,name=ready_check,json=readyCheck,proto3,oneof"`
+}
+
+type Envelope_ControlIntent struct {
+	ControlIntent *ControlIntent `protobuf:"bytes,30,opt,name=control_intent,json=controlIntent,proto3,oneof"`
+}
+
+type Envelope_ControlDecision struct {
+	ControlDecision *ControlDecision `protobuf:"bytes,31,opt,name=control_decision,json=controlDecision,proto3,oneof"`
+}
+
+type Envelope_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,32,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
+type Envelope_Reaction struct {
+	Reaction *Reaction `protobuf:"bytes,33,opt,name=reaction,proto3,oneof"`
+}
+
+type Envelope_ReactionBatch struct {
+	ReactionBatch *ReactionBatch `protobuf:"bytes,34,opt,name=reaction_batch,json=reactionBatch,proto3,oneof"`
+}
+
+type Envelope_PlaylistUpdateReq struct {
+	PlaylistUpdateReq *PlaylistUpdateReq `protobuf:"bytes,35,opt,name=playlist_update_req,json=playlistUpdateReq,proto3,oneof"`
+}
+
+type Envelope_ListRoomsReq struct {
+	ListRoomsReq *ListRoomsReq `protobuf:"bytes,36,opt,name=list_rooms_req,json=listRoomsReq,proto3,oneof"`
+}
+
+type Envelope_ListRoomsResp struct {
+	ListRoomsResp *ListRoomsResp `protobuf:"bytes,37,opt,name=list_rooms_resp,json=listRoomsResp,proto3,oneof"`
+}
+
+func (*Envelope_ClientHello) isEnvelope_Payload() {}
+
+func (*Envelope_ServerHello) isEnvelope_Payload() {}
+
+func (*Envelope_CreateRoomReq) isEnvelope_Payload() {}
+
+func (*Envelope_CreateRoomResp) isEnvelope_Payload() {}
+
+func (*Envelope_JoinRoomReq) isEnvelope_Payload() {}
+
+func (*Envelope_JoinRoomResp) isEnvelope_Payload() {}
+
+func (*Envelope_LeaveRoomReq) isEnvelope_Payload() {}
+
+func (*Envelope_RoomSnapshot) isEnvelope_Payload() {}
+
+func (*Envelope_HostState) isEnvelope_Payload() {}
+
+func (*Envelope_BroadcastState) isEnvelope_Payload() {}
+
+func (*Envelope_TimeSyncReq) isEnvelope_Payload() {}
+
+func (*Envelope_TimeSyncResp) isEnvelope_Payload() {}
+
+func (*Envelope_ErrorResp) isEnvelope_Payload() {}
+
+func (*Envelope_MemberStatus) isEnvelope_Payload() {}
+
+func (*Envelope_TransferHostReq) isEnvelope_Payload() {}
+
+func (*Envelope_JoinPendingResp) isEnvelope_Payload() {}
+
+func (*Envelope_JoinRequestNotice) isEnvelope_Payload() {}
+
+func (*Envelope_JoinDecision) isEnvelope_Payload() {}
+
+func (*Envelope_KickMemberReq) isEnvelope_Payload() {}
+
+func (*Envelope_BanMemberReq) isEnvelope_Payload() {}
+
+func (*Envelope_MuteMemberReq) isEnvelope_Payload() {}
+
+func (*Envelope_ServerNotice) isEnvelope_Payload() {}
+
+func (*Envelope_FollowerReport) isEnvelope_Payload() {}
+
+func (*Envelope_SyncHealth) isEnvelope_Payload() {}
+
+func (*Envelope_UpdateRoomSettingsReq) isEnvelope_Payload() {}
+
+func (*Envelope_GroupPlayback) isEnvelope_Payload() {}
+
+func (*Envelope_ReadyCheckReq) isEnvelope_Payload() {}
+
+func (*Envelope_ReadyCheckAck) isEnvelope_Payload() {}
+
+func (*Envelope_ReadyCheck) isEnvelope_Payload() {}
+
+func (*Envelope_ControlIntent) isEnvelope_Payload() {}
+
+func (*Envelope_ControlDecision) isEnvelope_Payload() {}
+
+func (*Envelope_ChatMessage) isEnvelope_Payload() {}
+
+func (*Envelope_Reaction) isEnvelope_Payload() {}
+
+func (*Envelope_ReactionBatch) isEnvelope_Payload() {}
+
+func (*Envelope_PlaylistUpdateReq) isEnvelope_Payload() {}
+
+func (*Envelope_ListRoomsReq) isEnvelope_Payload() {}
+
+func (*Envelope_ListRoomsResp) isEnvelope_Payload() {}
+
+type ClientHello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	ClientName    string `protobuf:"bytes,1,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
 	ClientVersion string `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// Token from a previous ServerHello; reattaches the old room slot if the
+	// server still holds it.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Stable per-install identity; room bans match it. It is chosen by the
+	// client, so it never reattaches a room slot; only resume_token does.
+	ClientIdentity string `protobuf:"bytes,4,opt,name=client_identity,json=clientIdentity,proto3" json:"client_identity,omitempty"`
+	// Newest protocol the client speaks. Clients that leave it unset are
+	// protocol 1, which has none of the capabilities below.
+	ProtocolVersion uint32 `protobuf:"varint,5,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// Optional features the client supports: "resume", "chat", "reactions",
+	// "playlist", "directory", "follower_reports", "group_playback",
+	// "shared_control".
+	Capabilities []string `protobuf:"bytes,6,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *ClientHello) Reset() {
@@ -324,6 +1022,34 @@ func (x *ClientHello) GetClientVersion() string {
 	return ""
 }
 
+func (x *ClientHello) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ClientHello) GetClientIdentity() string {
+	if x != nil {
+		return x.ClientIdentity
+	}
+	return ""
+}
+
+func (x *ClientHello) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ClientHello) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type ServerHello struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -331,6 +1057,14 @@ type ServerHello struct {
 
 	ClientId     string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ServerTimeMs int64  `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	ResumeToken  string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// True when resume_token reattached a held slot. The server follows up with
+	// a RoomSnapshot instead of expecting CreateRoomReq/JoinRoomReq.
+	Resumed bool `protobuf:"varint,4,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	// The protocol both sides speak, and the capabilities both support.
+	// Neither side sends messages for a capability missing here.
+	ProtocolVersion uint32   `protobuf:"varint,5,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Capabilities    []string `protobuf:"bytes,6,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *ServerHello) Reset() {
@@ -379,16 +1113,55 @@ func (x *ServerHello) GetServerTimeMs() int64 {
 	return 0
 }
 
-type CreateRoomReq struct {
+func (x *ServerHello) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ServerHello) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+func (x *ServerHello) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ServerHello) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type RoomSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-}
-
-func (x *CreateRoomReq) Reset() {
-	*x = CreateRoomReq{}
+	JoinPolicy JoinPolicy `protobuf:"varint,1,opt,name=join_policy,json=joinPolicy,proto3,enum=videowithyou.JoinPolicy" json:"join_policy,omitempty"`
+	// Pause the whole room while any active member is buffering and resume
+	// together once everyone is ready.
+	PauseOnBuffering bool        `protobuf:"varint,2,opt,name=pause_on_buffering,json=pauseOnBuffering,proto3" json:"pause_on_buffering,omitempty"`
+	ControlMode      ControlMode `protobuf:"varint,3,opt,name=control_mode,json=controlMode,proto3,enum=videowithyou.ControlMode" json:"control_mode,omitempty"`
+	// Let members other than the host add, move and remove playlist entries.
+	MembersEditPlaylist bool `protobuf:"varint,4,opt,name=members_edit_playlist,json=membersEditPlaylist,proto3" json:"members_edit_playlist,omitempty"`
+	// List the room in the public directory (ListRoomsReq) under title and
+	// description.
+	Public      bool   `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`
+	Title       string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *RoomSettings) Reset() {
+	*x = RoomSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,13 +1169,13 @@ func (x *CreateRoomReq) Reset() {
 	}
 }
 
-func (x *CreateRoomReq) String() string {
+func (x *RoomSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomReq) ProtoMessage() {}
+func (*RoomSettings) ProtoMessage() {}
 
-func (x *CreateRoomReq) ProtoReflect() protoreflect.Message {
+func (x *RoomSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,30 +1187,80 @@ func (x *CreateRoomReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomReq.ProtoReflect.Descriptor instead.
-func (*CreateRoomReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoomSettings.ProtoReflect.Descriptor instead.
+func (*RoomSettings) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateRoomReq) GetClientId() string {
+func (x *RoomSettings) GetJoinPolicy() JoinPolicy {
 	if x != nil {
-		return x.ClientId
+		return x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_OPEN
+}
+
+func (x *RoomSettings) GetPauseOnBuffering() bool {
+	if x != nil {
+		return x.PauseOnBuffering
+	}
+	return false
+}
+
+func (x *RoomSettings) GetControlMode() ControlMode {
+	if x != nil {
+		return x.ControlMode
+	}
+	return ControlMode_CONTROL_MODE_HOST_ONLY
+}
+
+func (x *RoomSettings) GetMembersEditPlaylist() bool {
+	if x != nil {
+		return x.MembersEditPlaylist
+	}
+	return false
+}
+
+func (x *RoomSettings) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *RoomSettings) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-type CreateRoomResp struct {
+func (x *RoomSettings) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// UpdateRoomSettingsReq changes the fields that are set. Only the host may
+// send it; the new settings arrive in the next RoomSnapshot.
+type UpdateRoomSettingsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	RoomCode     string `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
-	ServerTimeMs int64  `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RoomId              string       `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestId           string       `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PauseOnBuffering    *bool        `protobuf:"varint,3,opt,name=pause_on_buffering,json=pauseOnBuffering,proto3,oneof" json:"pause_on_buffering,omitempty"`
+	ControlMode         *ControlMode `protobuf:"varint,4,opt,name=control_mode,json=controlMode,proto3,enum=videowithyou.ControlMode,oneof" json:"control_mode,omitempty"`
+	MembersEditPlaylist *bool        `protobuf:"varint,5,opt,name=members_edit_playlist,json=membersEditPlaylist,proto3,oneof" json:"members_edit_playlist,omitempty"`
+	Public              *bool        `protobuf:"varint,6,opt,name=public,proto3,oneof" json:"public,omitempty"`
+	// title is at most 80 characters and description 500.
+	Title       *string `protobuf:"bytes,7,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
 }
 
-func (x *CreateRoomResp) Reset() {
-	*x = CreateRoomResp{}
+func (x *UpdateRoomSettingsReq) Reset() {
+	*x = UpdateRoomSettingsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,13 +1268,13 @@ func (x *CreateRoomResp) Reset() {
 	}
 }
 
-func (x *CreateRoomResp) String() string {
+func (x *UpdateRoomSettingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomResp) ProtoMessage() {}
+func (*UpdateRoomSettingsReq) ProtoMessage() {}
 
-func (x *CreateRoomResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateRoomSettingsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,43 +1286,83 @@ func (x *CreateRoomResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomResp.ProtoReflect.Descriptor instead.
-func (*CreateRoomResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRoomSettingsReq.ProtoReflect.Descriptor instead.
+func (*UpdateRoomSettingsReq) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateRoomResp) GetRoomId() string {
+func (x *UpdateRoomSettingsReq) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *CreateRoomResp) GetRoomCode() string {
+func (x *UpdateRoomSettingsReq) GetRequestId() string {
 	if x != nil {
-		return x.RoomCode
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CreateRoomResp) GetServerTimeMs() int64 {
-	if x != nil {
-		return x.ServerTimeMs
+func (x *UpdateRoomSettingsReq) GetPauseOnBuffering() bool {
+	if x != nil && x.PauseOnBuffering != nil {
+		return *x.PauseOnBuffering
 	}
-	return 0
+	return false
 }
 
-type JoinRoomReq struct {
+func (x *UpdateRoomSettingsReq) GetControlMode() ControlMode {
+	if x != nil && x.ControlMode != nil {
+		return *x.ControlMode
+	}
+	return ControlMode_CONTROL_MODE_HOST_ONLY
+}
+
+func (x *UpdateRoomSettingsReq) GetMembersEditPlaylist() bool {
+	if x != nil && x.MembersEditPlaylist != nil {
+		return *x.MembersEditPlaylist
+	}
+	return false
+}
+
+func (x *UpdateRoomSettingsReq) GetPublic() bool {
+	if x != nil && x.Public != nil {
+		return *x.Public
+	}
+	return false
+}
+
+func (x *UpdateRoomSettingsReq) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRoomSettingsReq) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CreateRoomReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	RoomCode string `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	ClientId  string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Setting a password with JOIN_POLICY_OPEN implies JOIN_POLICY_PASSWORD.
+	Password   string     `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	JoinPolicy JoinPolicy `protobuf:"varint,4,opt,name=join_policy,json=joinPolicy,proto3,enum=videowithyou.JoinPolicy" json:"join_policy,omitempty"`
+	// Schedules playback of premiere.media to start on its own; see Premiere.
+	Premiere *Premiere `protobuf:"bytes,5,opt,name=premiere,proto3" json:"premiere,omitempty"`
 }
 
-func (x *JoinRoomReq) Reset() {
-	*x = JoinRoomReq{}
+func (x *CreateRoomReq) Reset() {
+	*x = CreateRoomReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,13 +1370,13 @@ func (x *JoinRoomReq) Reset() {
 	}
 }
 
-func (x *JoinRoomReq) String() string {
+func (x *CreateRoomReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomReq) ProtoMessage() {}
+func (*CreateRoomReq) ProtoMessage() {}
 
-func (x *JoinRoomReq) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,37 +1388,61 @@ func (x *JoinRoomReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomReq.ProtoReflect.Descriptor instead.
-func (*JoinRoomReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomReq.ProtoReflect.Descriptor instead.
+func (*CreateRoomReq) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *JoinRoomReq) GetClientId() string {
+func (x *CreateRoomReq) GetClientId() string {
 	if x != nil {
 		return x.ClientId
 	}
 	return ""
 }
 
-func (x *JoinRoomReq) GetRoomCode() string {
+func (x *CreateRoomReq) GetRequestId() string {
 	if x != nil {
-		return x.RoomCode
+		return x.RequestId
 	}
 	return ""
 }
 
-type JoinRoomResp struct {
+func (x *CreateRoomReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateRoomReq) GetJoinPolicy() JoinPolicy {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_OPEN
+}
+
+func (x *CreateRoomReq) GetPremiere() *Premiere {
+	if x != nil {
+		return x.Premiere
+	}
+	return nil
+}
+
+// Premiere is a room's scheduled start. Until the host reports a state after
+// start_server_time_ms, the room's state is synthesized: position 0 at rate 1
+// sampled at the start time. Followers hold at 0 while that sample is in the
+// future.
+type Premiere struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	HostId       string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	ServerTimeMs int64  `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	StartServerTimeMs int64      `protobuf:"varint,1,opt,name=start_server_time_ms,json=startServerTimeMs,proto3" json:"start_server_time_ms,omitempty"`
+	Media             *MediaInfo `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
 }
 
-func (x *JoinRoomResp) Reset() {
-	*x = JoinRoomResp{}
+func (x *Premiere) Reset() {
+	*x = Premiere{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,13 +1450,13 @@ func (x *JoinRoomResp) Reset() {
 	}
 }
 
-func (x *JoinRoomResp) String() string {
+func (x *Premiere) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRoomResp) ProtoMessage() {}
+func (*Premiere) ProtoMessage() {}
 
-func (x *JoinRoomResp) ProtoReflect() protoreflect.Message {
+func (x *Premiere) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,43 +1468,38 @@ func (x *JoinRoomResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRoomResp.ProtoReflect.Descriptor instead.
-func (*JoinRoomResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use Premiere.ProtoReflect.Descriptor instead.
+func (*Premiere) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *JoinRoomResp) GetRoomId() string {
-	if x != nil {
-		return x.RoomId
-	}
-	return ""
-}
-
-func (x *JoinRoomResp) GetHostId() string {
+func (x *Premiere) GetStartServerTimeMs() int64 {
 	if x != nil {
-		return x.HostId
+		return x.StartServerTimeMs
 	}
-	return ""
+	return 0
 }
 
-func (x *JoinRoomResp) GetServerTimeMs() int64 {
+func (x *Premiere) GetMedia() *MediaInfo {
 	if x != nil {
-		return x.ServerTimeMs
+		return x.Media
 	}
-	return 0
+	return nil
 }
 
-type LeaveRoomReq struct {
+type CreateRoomResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	RoomId   string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomCode     string `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RequestId    string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *LeaveRoomReq) Reset() {
-	*x = LeaveRoomReq{}
+func (x *CreateRoomResp) Reset() {
+	*x = CreateRoomResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,13 +1507,13 @@ func (x *LeaveRoomReq) Reset() {
 	}
 }
 
-func (x *LeaveRoomReq) String() string {
+func (x *CreateRoomResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRoomReq) ProtoMessage() {}
+func (*CreateRoomResp) ProtoMessage() {}
 
-func (x *LeaveRoomReq) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomResp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,37 +1525,51 @@ func (x *LeaveRoomReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRoomReq.ProtoReflect.Descriptor instead.
-func (*LeaveRoomReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomResp.ProtoReflect.Descriptor instead.
+func (*CreateRoomResp) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LeaveRoomReq) GetClientId() string {
+func (x *CreateRoomResp) GetRoomId() string {
 	if x != nil {
-		return x.ClientId
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *LeaveRoomReq) GetRoomId() string {
+func (x *CreateRoomResp) GetRoomCode() string {
 	if x != nil {
-		return x.RoomId
+		return x.RoomCode
 	}
 	return ""
 }
 
-type MemberStatus struct {
+func (x *CreateRoomResp) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *CreateRoomResp) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// ListRoomsReq asks for the public room directory. It can be sent without
+// being in a room.
+type ListRoomsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId   string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	MemberId string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	Active   bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *MemberStatus) Reset() {
-	*x = MemberStatus{}
+func (x *ListRoomsReq) Reset() {
+	*x = ListRoomsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +1577,13 @@ func (x *MemberStatus) Reset() {
 	}
 }
 
-func (x *MemberStatus) String() string {
+func (x *ListRoomsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberStatus) ProtoMessage() {}
+func (*ListRoomsReq) ProtoMessage() {}
 
-func (x *MemberStatus) ProtoReflect() protoreflect.Message {
+func (x *ListRoomsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,44 +1595,30 @@ func (x *MemberStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberStatus.ProtoReflect.Descriptor instead.
-func (*MemberStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoomsReq.ProtoReflect.Descriptor instead.
+func (*ListRoomsReq) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MemberStatus) GetRoomId() string {
-	if x != nil {
-		return x.RoomId
-	}
-	return ""
-}
-
-func (x *MemberStatus) GetMemberId() string {
+func (x *ListRoomsReq) GetRequestId() string {
 	if x != nil {
-		return x.MemberId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *MemberStatus) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
-type Member struct {
+type ListRoomsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemberId    string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	IsHost      bool   `protobuf:"varint,3,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Rooms with the most members first.
+	Rooms []*PublicRoom `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
 }
 
-func (x *Member) Reset() {
-	*x = Member{}
+func (x *ListRoomsResp) Reset() {
+	*x = ListRoomsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,13 +1626,13 @@ func (x *Member) Reset() {
 	}
 }
 
-func (x *Member) String() string {
+func (x *ListRoomsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Member) ProtoMessage() {}
+func (*ListRoomsResp) ProtoMessage() {}
 
-func (x *Member) ProtoReflect() protoreflect.Message {
+func (x *ListRoomsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,45 +1644,46 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Member.ProtoReflect.Descriptor instead.
-func (*Member) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoomsResp.ProtoReflect.Descriptor instead.
+func (*ListRoomsResp) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Member) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
-	}
-	return ""
-}
-
-func (x *Member) GetDisplayName() string {
+func (x *ListRoomsResp) GetRequestId() string {
 	if x != nil {
-		return x.DisplayName
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *Member) GetIsHost() bool {
+func (x *ListRoomsResp) GetRooms() []*PublicRoom {
 	if x != nil {
-		return x.IsHost
+		return x.Rooms
 	}
-	return false
+	return nil
 }
 
-type MediaInfo struct {
+type PublicRoom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url   string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Title string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Site  string            `protobuf:"bytes,3,opt,name=site,proto3" json:"site,omitempty"`
-	Attrs map[string]string `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *MediaInfo) Reset() {
-	*x = MediaInfo{}
+	RoomId      string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomCode    string `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	HostName    string `protobuf:"bytes,5,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	MemberCount int32  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Title of the media playing now, from the room's latest HostState.
+	MediaTitle string `protobuf:"bytes,7,opt,name=media_title,json=mediaTitle,proto3" json:"media_title,omitempty"`
+	Playing    bool   `protobuf:"varint,8,opt,name=playing,proto3" json:"playing,omitempty"`
+	// Rooms needing a password or approval are listed too; join_policy says
+	// what joining takes.
+	JoinPolicy JoinPolicy `protobuf:"varint,9,opt,name=join_policy,json=joinPolicy,proto3,enum=videowithyou.JoinPolicy" json:"join_policy,omitempty"`
+}
+
+func (x *PublicRoom) Reset() {
+	*x = PublicRoom{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +1691,13 @@ func (x *MediaInfo) Reset() {
 	}
 }
 
-func (x *MediaInfo) String() string {
+func (x *PublicRoom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediaInfo) ProtoMessage() {}
+func (*PublicRoom) ProtoMessage() {}
 
-func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+func (x *PublicRoom) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,57 +1709,87 @@ func (x *MediaInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
-func (*MediaInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublicRoom.ProtoReflect.Descriptor instead.
+func (*PublicRoom) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MediaInfo) GetUrl() string {
+func (x *PublicRoom) GetRoomId() string {
 	if x != nil {
-		return x.Url
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *MediaInfo) GetTitle() string {
+func (x *PublicRoom) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *PublicRoom) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *MediaInfo) GetSite() string {
+func (x *PublicRoom) GetDescription() string {
 	if x != nil {
-		return x.Site
+		return x.Description
 	}
 	return ""
 }
 
-func (x *MediaInfo) GetAttrs() map[string]string {
+func (x *PublicRoom) GetHostName() string {
 	if x != nil {
-		return x.Attrs
+		return x.HostName
 	}
-	return nil
+	return ""
 }
 
-type HostState struct {
+func (x *PublicRoom) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *PublicRoom) GetMediaTitle() string {
+	if x != nil {
+		return x.MediaTitle
+	}
+	return ""
+}
+
+func (x *PublicRoom) GetPlaying() bool {
+	if x != nil {
+		return x.Playing
+	}
+	return false
+}
+
+func (x *PublicRoom) GetJoinPolicy() JoinPolicy {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return JoinPolicy_JOIN_POLICY_OPEN
+}
+
+type JoinRoomReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId             string     `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	HostId             string     `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Seq                uint64     `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
-	Media              *MediaInfo `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
-	PositionMs         int64      `protobuf:"varint,5,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
-	Rate               float64    `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
-	Paused             bool       `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
-	SampleServerTimeMs int64      `protobuf:"varint,8,opt,name=sample_server_time_ms,json=sampleServerTimeMs,proto3" json:"sample_server_time_ms,omitempty"`
-	OffsetMs           int64      `protobuf:"varint,9,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	ClientId  string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RoomCode  string `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Password  string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *HostState) Reset() {
-	*x = HostState{}
+func (x *JoinRoomReq) Reset() {
+	*x = JoinRoomReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,13 +1797,13 @@ func (x *HostState) Reset() {
 	}
 }
 
-func (x *HostState) String() string {
+func (x *JoinRoomReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostState) ProtoMessage() {}
+func (*JoinRoomReq) ProtoMessage() {}
 
-func (x *HostState) ProtoReflect() protoreflect.Message {
+func (x *JoinRoomReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,86 +1815,54 @@ func (x *HostState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostState.ProtoReflect.Descriptor instead.
-func (*HostState) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRoomReq.ProtoReflect.Descriptor instead.
+func (*JoinRoomReq) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *HostState) GetRoomId() string {
+func (x *JoinRoomReq) GetClientId() string {
 	if x != nil {
-		return x.RoomId
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *HostState) GetHostId() string {
+func (x *JoinRoomReq) GetRoomCode() string {
 	if x != nil {
-		return x.HostId
+		return x.RoomCode
 	}
 	return ""
 }
 
-func (x *HostState) GetSeq() uint64 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
-}
-
-func (x *HostState) GetMedia() *MediaInfo {
-	if x != nil {
-		return x.Media
-	}
-	return nil
-}
-
-func (x *HostState) GetPositionMs() int64 {
-	if x != nil {
-		return x.PositionMs
-	}
-	return 0
-}
-
-func (x *HostState) GetRate() float64 {
-	if x != nil {
-		return x.Rate
-	}
-	return 0
-}
-
-func (x *HostState) GetPaused() bool {
-	if x != nil {
-		return x.Paused
-	}
-	return false
-}
-
-func (x *HostState) GetSampleServerTimeMs() int64 {
+func (x *JoinRoomReq) GetRequestId() string {
 	if x != nil {
-		return x.SampleServerTimeMs
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-func (x *HostState) GetOffsetMs() int64 {
+func (x *JoinRoomReq) GetPassword() string {
 	if x != nil {
-		return x.OffsetMs
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-type BroadcastState struct {
+type JoinRoomResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State        *HostState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	ServerTimeMs int64      `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
-	Members      []*Member  `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HostId       string `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RequestId    string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The room's most recent chat messages, oldest first.
+	RecentChat []*ChatMessage `protobuf:"bytes,5,rep,name=recent_chat,json=recentChat,proto3" json:"recent_chat,omitempty"`
 }
 
-func (x *BroadcastState) Reset() {
-	*x = BroadcastState{}
+func (x *JoinRoomResp) Reset() {
+	*x = JoinRoomResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,13 +1870,13 @@ func (x *BroadcastState) Reset() {
 	}
 }
 
-func (x *BroadcastState) String() string {
+func (x *JoinRoomResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastState) ProtoMessage() {}
+func (*JoinRoomResp) ProtoMessage() {}
 
-func (x *BroadcastState) ProtoReflect() protoreflect.Message {
+func (x *JoinRoomResp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,47 +1888,59 @@ func (x *BroadcastState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastState.ProtoReflect.Descriptor instead.
-func (*BroadcastState) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRoomResp.ProtoReflect.Descriptor instead.
+func (*JoinRoomResp) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BroadcastState) GetState() *HostState {
+func (x *JoinRoomResp) GetRoomId() string {
 	if x != nil {
-		return x.State
+		return x.RoomId
 	}
-	return nil
+	return ""
 }
 
-func (x *BroadcastState) GetServerTimeMs() int64 {
+func (x *JoinRoomResp) GetHostId() string {
 	if x != nil {
-		return x.ServerTimeMs
+		return x.HostId
 	}
-	return 0
+	return ""
 }
 
-func (x *BroadcastState) GetMembers() []*Member {
+func (x *JoinRoomResp) GetServerTimeMs() int64 {
 	if x != nil {
-		return x.Members
+		return x.ServerTimeMs
 	}
-	return nil
+	return 0
 }
 
-type RoomSnapshot struct {
+func (x *JoinRoomResp) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *JoinRoomResp) GetRecentChat() []*ChatMessage {
+	if x != nil {
+		return x.RecentChat
+	}
+	return nil
+}
+
+// Sent to a joiner parked in an approval room until the host decides.
+type JoinPendingResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId       string     `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	RoomCode     string     `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
-	HostId       string     `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Members      []*Member  `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
-	LatestState  *HostState `protobuf:"bytes,5,opt,name=latest_state,json=latestState,proto3" json:"latest_state,omitempty"`
-	ServerTimeMs int64      `protobuf:"varint,6,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestId    string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
 }
 
-func (x *RoomSnapshot) Reset() {
-	*x = RoomSnapshot{}
+func (x *JoinPendingResp) Reset() {
+	*x = JoinPendingResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1055,13 +1948,13 @@ func (x *RoomSnapshot) Reset() {
 	}
 }
 
-func (x *RoomSnapshot) String() string {
+func (x *JoinPendingResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomSnapshot) ProtoMessage() {}
+func (*JoinPendingResp) ProtoMessage() {}
 
-func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
+func (x *JoinPendingResp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,63 +1966,47 @@ func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomSnapshot.ProtoReflect.Descriptor instead.
-func (*RoomSnapshot) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinPendingResp.ProtoReflect.Descriptor instead.
+func (*JoinPendingResp) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RoomSnapshot) GetRoomId() string {
+func (x *JoinPendingResp) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *RoomSnapshot) GetRoomCode() string {
-	if x != nil {
-		return x.RoomCode
-	}
-	return ""
-}
-
-func (x *RoomSnapshot) GetHostId() string {
+func (x *JoinPendingResp) GetRequestId() string {
 	if x != nil {
-		return x.HostId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *RoomSnapshot) GetMembers() []*Member {
-	if x != nil {
-		return x.Members
-	}
-	return nil
-}
-
-func (x *RoomSnapshot) GetLatestState() *HostState {
-	if x != nil {
-		return x.LatestState
-	}
-	return nil
-}
-
-func (x *RoomSnapshot) GetServerTimeMs() int64 {
+func (x *JoinPendingResp) GetServerTimeMs() int64 {
 	if x != nil {
 		return x.ServerTimeMs
 	}
 	return 0
 }
 
-type TimeSyncReq struct {
+// Sent to the host when someone knocks, or withdraws a knock.
+type JoinRequestNotice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	T1LocalMs int64 `protobuf:"varint,1,opt,name=t1_local_ms,json=t1LocalMs,proto3" json:"t1_local_ms,omitempty"`
+	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId     string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName  string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Withdrawn    bool   `protobuf:"varint,4,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,5,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
 }
 
-func (x *TimeSyncReq) Reset() {
-	*x = TimeSyncReq{}
+func (x *JoinRequestNotice) Reset() {
+	*x = JoinRequestNotice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,13 +2014,13 @@ func (x *TimeSyncReq) Reset() {
 	}
 }
 
-func (x *TimeSyncReq) String() string {
+func (x *JoinRequestNotice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSyncReq) ProtoMessage() {}
+func (*JoinRequestNotice) ProtoMessage() {}
 
-func (x *TimeSyncReq) ProtoReflect() protoreflect.Message {
+func (x *JoinRequestNotice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,31 +2032,59 @@ func (x *TimeSyncReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSyncReq.ProtoReflect.Descriptor instead.
-func (*TimeSyncReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRequestNotice.ProtoReflect.Descriptor instead.
+func (*JoinRequestNotice) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TimeSyncReq) GetT1LocalMs() int64 {
+func (x *JoinRequestNotice) GetRoomId() string {
 	if x != nil {
-		return x.T1LocalMs
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *JoinRequestNotice) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *JoinRequestNotice) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *JoinRequestNotice) GetWithdrawn() bool {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return false
+}
+
+func (x *JoinRequestNotice) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
 	}
 	return 0
 }
 
-type TimeSyncResp struct {
+type JoinDecision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	T1LocalMs    int64 `protobuf:"varint,1,opt,name=t1_local_ms,json=t1LocalMs,proto3" json:"t1_local_ms,omitempty"`
-	T2ServerMs   int64 `protobuf:"varint,2,opt,name=t2_server_ms,json=t2ServerMs,proto3" json:"t2_server_ms,omitempty"`
-	T3ServerMs   int64 `protobuf:"varint,3,opt,name=t3_server_ms,json=t3ServerMs,proto3" json:"t3_server_ms,omitempty"`
-	ServerTimeMs int64 `protobuf:"varint,4,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId  string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Approve   bool   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *TimeSyncResp) Reset() {
-	*x = TimeSyncResp{}
+func (x *JoinDecision) Reset() {
+	*x = JoinDecision{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1187,13 +2092,13 @@ func (x *TimeSyncResp) Reset() {
 	}
 }
 
-func (x *TimeSyncResp) String() string {
+func (x *JoinDecision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSyncResp) ProtoMessage() {}
+func (*JoinDecision) ProtoMessage() {}
 
-func (x *TimeSyncResp) ProtoReflect() protoreflect.Message {
+func (x *JoinDecision) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1205,50 +2110,51 @@ func (x *TimeSyncResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSyncResp.ProtoReflect.Descriptor instead.
-func (*TimeSyncResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinDecision.ProtoReflect.Descriptor instead.
+func (*JoinDecision) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TimeSyncResp) GetT1LocalMs() int64 {
+func (x *JoinDecision) GetRoomId() string {
 	if x != nil {
-		return x.T1LocalMs
+		return x.RoomId
 	}
-	return 0
+	return ""
 }
 
-func (x *TimeSyncResp) GetT2ServerMs() int64 {
+func (x *JoinDecision) GetMemberId() string {
 	if x != nil {
-		return x.T2ServerMs
+		return x.MemberId
 	}
-	return 0
+	return ""
 }
 
-func (x *TimeSyncResp) GetT3ServerMs() int64 {
+func (x *JoinDecision) GetApprove() bool {
 	if x != nil {
-		return x.T3ServerMs
+		return x.Approve
 	}
-	return 0
+	return false
 }
 
-func (x *TimeSyncResp) GetServerTimeMs() int64 {
+func (x *JoinDecision) GetRequestId() string {
 	if x != nil {
-		return x.ServerTimeMs
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type ErrorResp struct {
+type KickMemberReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	ServerTimeMs int64  `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId  string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (x *ErrorResp) Reset() {
-	*x = ErrorResp{}
+func (x *KickMemberReq) Reset() {
+	*x = KickMemberReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_videowithyou_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,13 +2162,13 @@ func (x *ErrorResp) Reset() {
 	}
 }
 
-func (x *ErrorResp) String() string {
+func (x *KickMemberReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorResp) ProtoMessage() {}
+func (*KickMemberReq) ProtoMessage() {}
 
-func (x *ErrorResp) ProtoReflect() protoreflect.Message {
+func (x *KickMemberReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_videowithyou_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,296 +2180,3432 @@ func (x *ErrorResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorResp.ProtoReflect.Descriptor instead.
-func (*ErrorResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use KickMemberReq.ProtoReflect.Descriptor instead.
+func (*KickMemberReq) Descriptor() ([]byte, []int) {
 	return file_proto_videowithyou_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ErrorResp) GetMessage() string {
+func (x *KickMemberReq) GetRoomId() string {
 	if x != nil {
-		return x.Message
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *ErrorResp) GetServerTimeMs() int64 {
+func (x *KickMemberReq) GetMemberId() string {
 	if x != nil {
-		return x.ServerTimeMs
+		return x.MemberId
 	}
-	return 0
+	return ""
 }
 
-var File_proto_videowithyou_proto protoreflect.FileDescriptor
+func (x *KickMemberReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
 
-var file_proto_videowithyou_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
-	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x22, 0xb7, 0x07, 0x0a, 0x08, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x45, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x48, 0x0a, 0x10,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
-	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72,
-	0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69,
-	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x6a,
-	0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0e, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79,
-	0x6f, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48,
-	0x00, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12,
-	0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
-	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
-	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0f,
-	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
-	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x79,
-	0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
-	0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x22, 0x55, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x2c, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x72,
-	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
-	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x47, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0x66, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x44, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x5c,
-	0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
-	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x61, 0x0a, 0x06,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x22,
-	0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x74, 0x74,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x74,
-	0x74, 0x72, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x02,
-	0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72,
-	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
-	0x6f, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
-	0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
-	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0e,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
-	0x65, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
-	0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
-	0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
-	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x2d, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x31, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x31, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x4d, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x31, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x31, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x32, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x32, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x33, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
-	0x33, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22,
-	0x4b, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x42, 0x2a, 0x5a, 0x28,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2f, 0x76, 0x32, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x3b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77,
-	0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Bans last for the lifetime of the room.
+type BanMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId  string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-var (
-	file_proto_videowithyou_proto_rawDescOnce sync.Once
-	file_proto_videowithyou_proto_rawDescData = file_proto_videowithyou_proto_rawDesc
-)
+func (x *BanMemberReq) Reset() {
+	*x = BanMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_videowithyou_proto_rawDescGZIP() []byte {
-	file_proto_videowithyou_proto_rawDescOnce.Do(func() {
-		file_proto_videowithyou_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_videowithyou_proto_rawDescData)
-	})
-	return file_proto_videowithyou_proto_rawDescData
+func (x *BanMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_videowithyou_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_proto_videowithyou_proto_goTypes = []any{
-	(*Envelope)(nil),       // 0: videowithyou.Envelope
-	(*ClientHello)(nil),    // 1: videowithyou.ClientHello
-	(*ServerHello)(nil),    // 2: videowithyou.ServerHello
-	(*CreateRoomReq)(nil),  // 3: videowithyou.CreateRoomReq
-	(*CreateRoomResp)(nil), // 4: videowithyou.CreateRoomResp
-	(*JoinRoomReq)(nil),    // 5: videowithyou.JoinRoomReq
-	(*JoinRoomResp)(nil),   // 6: videowithyou.JoinRoomResp
-	(*LeaveRoomReq)(nil),   // 7: videowithyou.LeaveRoomReq
-	(*MemberStatus)(nil),   // 8: videowithyou.MemberStatus
-	(*Member)(nil),         // 9: videowithyou.Member
-	(*MediaInfo)(nil),      // 10: videowithyou.MediaInfo
-	(*HostState)(nil),      // 11: videowithyou.HostState
-	(*BroadcastState)(nil), // 12: videowithyou.BroadcastState
-	(*RoomSnapshot)(nil),   // 13: videowithyou.RoomSnapshot
-	(*TimeSyncReq)(nil),    // 14: videowithyou.TimeSyncReq
-	(*TimeSyncResp)(nil),   // 15: videowithyou.TimeSyncResp
-	(*ErrorResp)(nil),      // 16: videowithyou.ErrorResp
-	nil,                    // 17: videowithyou.MediaInfo.AttrsEntry
+func (*BanMemberReq) ProtoMessage() {}
+
+func (x *BanMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_videowithyou_proto_depIdxs = []int32{
-	1,  // 0: videowithyou.Envelope.client_hello:type_name -> videowithyou.ClientHello
-	2,  // 1: videowithyou.Envelope.server_hello:type_name -> videowithyou.ServerHello
-	3,  // 2: videowithyou.Envelope.create_room_req:type_name -> videowithyou.CreateRoomReq
-	4,  // 3: videowithyou.Envelope.create_room_resp:type_name -> videowithyou.CreateRoomResp
-	5,  // 4: videowithyou.Envelope.join_room_req:type_name -> videowithyou.JoinRoomReq
-	6,  // 5: videowithyou.Envelope.join_room_resp:type_name -> videowithyou.JoinRoomResp
-	7,  // 6: videowithyou.Envelope.leave_room_req:type_name -> videowithyou.LeaveRoomReq
-	13, // 7: videowithyou.Envelope.room_snapshot:type_name -> videowithyou.RoomSnapshot
-	11, // 8: videowithyou.Envelope.host_state:type_name -> videowithyou.HostState
-	12, // 9: videowithyou.Envelope.broadcast_state:type_name -> videowithyou.BroadcastState
-	14, // 10: videowithyou.Envelope.time_sync_req:type_name -> videowithyou.TimeSyncReq
-	15, // 11: videowithyou.Envelope.time_sync_resp:type_name -> videowithyou.TimeSyncResp
-	16, // 12: videowithyou.Envelope.error_resp:type_name -> videowithyou.ErrorResp
-	8,  // 13: videowithyou.Envelope.member_status:type_name -> videowithyou.MemberStatus
-	17, // 14: videowithyou.MediaInfo.attrs:type_name -> videowithyou.MediaInfo.AttrsEntry
-	10, // 15: videowithyou.HostState.media:type_name -> videowithyou.MediaInfo
-	11, // 16: videowithyou.BroadcastState.state:type_name -> videowithyou.HostState
-	9,  // 17: videowithyou.BroadcastState.members:type_name -> videowithyou.Member
-	9,  // 18: videowithyou.RoomSnapshot.members:type_name -> videowithyou.Member
-	11, // 19: videowithyou.RoomSnapshot.latest_state:type_name -> videowithyou.HostState
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+
+// Deprecated: Use BanMemberReq.ProtoReflect.Descriptor instead.
+func (*BanMemberReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_proto_videowithyou_proto_init() }
-func file_proto_videowithyou_proto_init() {
-	if File_proto_videowithyou_proto != nil {
-		return
+func (x *BanMemberReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_videowithyou_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Envelope); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
+	return ""
+}
+
+func (x *BanMemberReq) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *BanMemberReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Muted members stay in the room, but messages they send to other members
+// are refused with ERROR_CODE_MUTED.
+type MuteMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId  string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Muted     bool   `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *MuteMemberReq) Reset() {
+	*x = MuteMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberReq) ProtoMessage() {}
+
+func (x *MuteMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberReq.ProtoReflect.Descriptor instead.
+func (*MuteMemberReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MuteMemberReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *MuteMemberReq) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MuteMemberReq) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *MuteMemberReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type LeaveRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RoomId   string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Optional member to hand the host role to when the host leaves.
+	SuccessorId string `protobuf:"bytes,3,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+	RequestId   string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *LeaveRoomReq) Reset() {
+	*x = LeaveRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomReq) ProtoMessage() {}
+
+func (x *LeaveRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomReq.ProtoReflect.Descriptor instead.
+func (*LeaveRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LeaveRoomReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LeaveRoomReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *LeaveRoomReq) GetSuccessorId() string {
+	if x != nil {
+		return x.SuccessorId
+	}
+	return ""
+}
+
+func (x *LeaveRoomReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type TransferHostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TargetId  string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *TransferHostReq) Reset() {
+	*x = TransferHostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferHostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferHostReq) ProtoMessage() {}
+
+func (x *TransferHostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferHostReq.ProtoReflect.Descriptor instead.
+func (*TransferHostReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TransferHostReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TransferHostReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *TransferHostReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type MemberStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId   string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Active   bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// The member's player is stalled waiting for data.
+	Buffering bool `protobuf:"varint,4,opt,name=buffering,proto3" json:"buffering,omitempty"`
+}
+
+func (x *MemberStatus) Reset() {
+	*x = MemberStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberStatus) ProtoMessage() {}
+
+func (x *MemberStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberStatus.ProtoReflect.Descriptor instead.
+func (*MemberStatus) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MemberStatus) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *MemberStatus) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *MemberStatus) GetBuffering() bool {
+	if x != nil {
+		return x.Buffering
+	}
+	return false
+}
+
+// GroupPlayback holds or releases the whole room. A hold (paused) tells every
+// member to pause at position_ms; a release tells them to start playing from
+// position_ms at resume_at_server_time_ms.
+type GroupPlayback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId               string  `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Paused               bool    `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	PositionMs           int64   `protobuf:"varint,3,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Rate                 float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	ResumeAtServerTimeMs int64   `protobuf:"varint,5,opt,name=resume_at_server_time_ms,json=resumeAtServerTimeMs,proto3" json:"resume_at_server_time_ms,omitempty"`
+	// Members the room is waiting for while held.
+	WaitingMemberIds []string `protobuf:"bytes,6,rep,name=waiting_member_ids,json=waitingMemberIds,proto3" json:"waiting_member_ids,omitempty"`
+	ServerTimeMs     int64    `protobuf:"varint,7,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+}
+
+func (x *GroupPlayback) Reset() {
+	*x = GroupPlayback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupPlayback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPlayback) ProtoMessage() {}
+
+func (x *GroupPlayback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPlayback.ProtoReflect.Descriptor instead.
+func (*GroupPlayback) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroupPlayback) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GroupPlayback) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GroupPlayback) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *GroupPlayback) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *GroupPlayback) GetResumeAtServerTimeMs() int64 {
+	if x != nil {
+		return x.ResumeAtServerTimeMs
+	}
+	return 0
+}
+
+func (x *GroupPlayback) GetWaitingMemberIds() []string {
+	if x != nil {
+		return x.WaitingMemberIds
+	}
+	return nil
+}
+
+func (x *GroupPlayback) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+// ReadyCheckReq starts a ready check. Only the host may send it; a new check
+// replaces one in progress.
+type ReadyCheckReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// How long to wait for members before starting anyway; the server picks a
+	// default when zero.
+	TimeoutMs int64 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *ReadyCheckReq) Reset() {
+	*x = ReadyCheckReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyCheckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyCheckReq) ProtoMessage() {}
+
+func (x *ReadyCheckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyCheckReq.ProtoReflect.Descriptor instead.
+func (*ReadyCheckReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReadyCheckReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReadyCheckReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReadyCheckReq) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// ReadyCheckAck marks the sender ready (or not ready) for check_id.
+type ReadyCheckAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId  string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckId string `protobuf:"bytes,2,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
+	Ready   bool   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *ReadyCheckAck) Reset() {
+	*x = ReadyCheckAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyCheckAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyCheckAck) ProtoMessage() {}
+
+func (x *ReadyCheckAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyCheckAck.ProtoReflect.Descriptor instead.
+func (*ReadyCheckAck) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReadyCheckAck) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReadyCheckAck) GetCheckId() string {
+	if x != nil {
+		return x.CheckId
+	}
+	return ""
+}
+
+func (x *ReadyCheckAck) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+// ReadyCheck reports a ready check's progress to every member. The final
+// message has done set: members should pause at position_ms and start
+// playing at start_at_server_time_ms.
+type ReadyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckId              string   `protobuf:"bytes,2,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
+	ReadyMemberIds       []string `protobuf:"bytes,3,rep,name=ready_member_ids,json=readyMemberIds,proto3" json:"ready_member_ids,omitempty"`
+	WaitingMemberIds     []string `protobuf:"bytes,4,rep,name=waiting_member_ids,json=waitingMemberIds,proto3" json:"waiting_member_ids,omitempty"`
+	DeadlineServerTimeMs int64    `protobuf:"varint,5,opt,name=deadline_server_time_ms,json=deadlineServerTimeMs,proto3" json:"deadline_server_time_ms,omitempty"`
+	Done                 bool     `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	// Set when the check ended because the deadline passed.
+	TimedOut            bool    `protobuf:"varint,7,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	PositionMs          int64   `protobuf:"varint,8,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Rate                float64 `protobuf:"fixed64,9,opt,name=rate,proto3" json:"rate,omitempty"`
+	StartAtServerTimeMs int64   `protobuf:"varint,10,opt,name=start_at_server_time_ms,json=startAtServerTimeMs,proto3" json:"start_at_server_time_ms,omitempty"`
+	ServerTimeMs        int64   `protobuf:"varint,11,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+}
+
+func (x *ReadyCheck) Reset() {
+	*x = ReadyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyCheck) ProtoMessage() {}
+
+func (x *ReadyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyCheck.ProtoReflect.Descriptor instead.
+func (*ReadyCheck) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReadyCheck) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReadyCheck) GetCheckId() string {
+	if x != nil {
+		return x.CheckId
+	}
+	return ""
+}
+
+func (x *ReadyCheck) GetReadyMemberIds() []string {
+	if x != nil {
+		return x.ReadyMemberIds
+	}
+	return nil
+}
+
+func (x *ReadyCheck) GetWaitingMemberIds() []string {
+	if x != nil {
+		return x.WaitingMemberIds
+	}
+	return nil
+}
+
+func (x *ReadyCheck) GetDeadlineServerTimeMs() int64 {
+	if x != nil {
+		return x.DeadlineServerTimeMs
+	}
+	return 0
+}
+
+func (x *ReadyCheck) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ReadyCheck) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *ReadyCheck) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *ReadyCheck) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ReadyCheck) GetStartAtServerTimeMs() int64 {
+	if x != nil {
+		return x.StartAtServerTimeMs
+	}
+	return 0
+}
+
+func (x *ReadyCheck) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+// ControlIntent is a follower's local play, pause or seek. The server
+// forwards it to the host, whose client applies it so the change reaches
+// everyone in the next HostState. Refused with ERROR_CODE_NOT_HOST in
+// host-only rooms.
+type ControlIntent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId   string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	IntentId string `protobuf:"bytes,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	// Set by the server.
+	MemberId    string        `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName string        `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Action      ControlAction `protobuf:"varint,5,opt,name=action,proto3,enum=videowithyou.ControlAction" json:"action,omitempty"`
+	// position_ms is on the host's timeline.
+	PositionMs int64 `protobuf:"varint,6,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	// Set by the server in CONTROL_MODE_REQUEST rooms.
+	RequiresApproval bool  `protobuf:"varint,7,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	ServerTimeMs     int64 `protobuf:"varint,8,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+}
+
+func (x *ControlIntent) Reset() {
+	*x = ControlIntent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlIntent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlIntent) ProtoMessage() {}
+
+func (x *ControlIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlIntent.ProtoReflect.Descriptor instead.
+func (*ControlIntent) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ControlIntent) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ControlIntent) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *ControlIntent) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ControlIntent) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ControlIntent) GetAction() ControlAction {
+	if x != nil {
+		return x.Action
+	}
+	return ControlAction_CONTROL_ACTION_UNSPECIFIED
+}
+
+func (x *ControlIntent) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *ControlIntent) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *ControlIntent) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+// ControlDecision is the host's answer to a ControlIntent that required
+// approval; the server forwards it to the requesting member.
+type ControlDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId   string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	IntentId string `protobuf:"bytes,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	MemberId string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Approve  bool   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *ControlDecision) Reset() {
+	*x = ControlDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlDecision) ProtoMessage() {}
+
+func (x *ControlDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlDecision.ProtoReflect.Descriptor instead.
+func (*ControlDecision) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ControlDecision) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ControlDecision) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *ControlDecision) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ControlDecision) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+// ChatMessage is sent by a member with room_id, text and request_id; the
+// server fills in the rest and relays it to every member, sender included.
+// Over-long text is refused with ERROR_CODE_INVALID_REQUEST and fast
+// senders with ERROR_CODE_RATE_LIMITED.
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId       string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MessageId    string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MemberId     string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName  string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Text         string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,6,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RequestId    string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChatMessage) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Reaction is an emoji or short comment pinned to a playback position of the
+// room's media rather than to wall-clock time. A member sends room_id,
+// position_ms (on the host timeline), emoji and/or text and request_id; the
+// server stamps the rest, files it under the current media and relays it to
+// every member, sender included.
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId      string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ReactionId  string `protobuf:"bytes,2,opt,name=reaction_id,json=reactionId,proto3" json:"reaction_id,omitempty"`
+	MemberId    string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Identity of the media the reaction belongs to; set by the server.
+	MediaUrl     string `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaTitle   string `protobuf:"bytes,6,opt,name=media_title,json=mediaTitle,proto3" json:"media_title,omitempty"`
+	PositionMs   int64  `protobuf:"varint,7,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Emoji        string `protobuf:"bytes,8,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Text         string `protobuf:"bytes,9,opt,name=text,proto3" json:"text,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,10,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	RequestId    string `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Reaction) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *Reaction) GetReactionId() string {
+	if x != nil {
+		return x.ReactionId
+	}
+	return ""
+}
+
+func (x *Reaction) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Reaction) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Reaction) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *Reaction) GetMediaTitle() string {
+	if x != nil {
+		return x.MediaTitle
+	}
+	return ""
+}
+
+func (x *Reaction) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Reaction) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *Reaction) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// ReactionBatch replays the reactions stored for one media, ordered by
+// position. The server sends it on join and whenever the room's media
+// changes.
+type ReactionBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string      `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MediaUrl  string      `protobuf:"bytes,2,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Reactions []*Reaction `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *ReactionBatch) Reset() {
+	*x = ReactionBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactionBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionBatch) ProtoMessage() {}
+
+func (x *ReactionBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionBatch.ProtoReflect.Descriptor instead.
+func (*ReactionBatch) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReactionBatch) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReactionBatch) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *ReactionBatch) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// Playlist is the room's shared queue: the entry being played, if it came
+// from the queue, and the entries that play next, in order.
+type Playlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current *PlaylistEntry   `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Entries []*PlaylistEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Playlist) GetCurrent() *PlaylistEntry {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *Playlist) GetEntries() []*PlaylistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PlaylistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryId     string     `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Media       *MediaInfo `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
+	AddedBy     string     `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	AddedByName string     `protobuf:"bytes,4,opt,name=added_by_name,json=addedByName,proto3" json:"added_by_name,omitempty"`
+}
+
+func (x *PlaylistEntry) Reset() {
+	*x = PlaylistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistEntry) ProtoMessage() {}
+
+func (x *PlaylistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistEntry.ProtoReflect.Descriptor instead.
+func (*PlaylistEntry) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlaylistEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetMedia() *MediaInfo {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PlaylistEntry) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetAddedByName() string {
+	if x != nil {
+		return x.AddedByName
+	}
+	return ""
+}
+
+// PlaylistUpdateReq changes the room's playlist. The host may always edit
+// it; other members may add, move and remove entries only with the room's
+// members_edit_playlist setting and are refused with ERROR_CODE_NOT_HOST
+// otherwise. Changes reach everyone in a RoomSnapshot.
+type PlaylistUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId    string     `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RequestId string     `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Op        PlaylistOp `protobuf:"varint,3,opt,name=op,proto3,enum=videowithyou.PlaylistOp" json:"op,omitempty"`
+	Media     *MediaInfo `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
+	EntryId   string     `protobuf:"bytes,5,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Index     int32      `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *PlaylistUpdateReq) Reset() {
+	*x = PlaylistUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistUpdateReq) ProtoMessage() {}
+
+func (x *PlaylistUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistUpdateReq.ProtoReflect.Descriptor instead.
+func (*PlaylistUpdateReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlaylistUpdateReq) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *PlaylistUpdateReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlaylistUpdateReq) GetOp() PlaylistOp {
+	if x != nil {
+		return x.Op
+	}
+	return PlaylistOp_PLAYLIST_OP_UNSPECIFIED
+}
+
+func (x *PlaylistUpdateReq) GetMedia() *MediaInfo {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PlaylistUpdateReq) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *PlaylistUpdateReq) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// FollowerReport is a follower's periodic view of how far it is from the
+// host.
+type FollowerReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId   string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MemberId string `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// drift_ms is host target position minus local position.
+	DriftMs    int64   `protobuf:"varint,3,opt,name=drift_ms,json=driftMs,proto3" json:"drift_ms,omitempty"`
+	PositionMs int64   `protobuf:"varint,4,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Rate       float64 `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Endpoint   string  `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// last_action is the most recent correction the follower applied.
+	LastAction             SyncAction `protobuf:"varint,7,opt,name=last_action,json=lastAction,proto3,enum=videowithyou.SyncAction" json:"last_action,omitempty"`
+	LastActionServerTimeMs int64      `protobuf:"varint,8,opt,name=last_action_server_time_ms,json=lastActionServerTimeMs,proto3" json:"last_action_server_time_ms,omitempty"`
+	SampleServerTimeMs     int64      `protobuf:"varint,9,opt,name=sample_server_time_ms,json=sampleServerTimeMs,proto3" json:"sample_server_time_ms,omitempty"`
+}
+
+func (x *FollowerReport) Reset() {
+	*x = FollowerReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowerReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowerReport) ProtoMessage() {}
+
+func (x *FollowerReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowerReport.ProtoReflect.Descriptor instead.
+func (*FollowerReport) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FollowerReport) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *FollowerReport) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *FollowerReport) GetDriftMs() int64 {
+	if x != nil {
+		return x.DriftMs
+	}
+	return 0
+}
+
+func (x *FollowerReport) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *FollowerReport) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *FollowerReport) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *FollowerReport) GetLastAction() SyncAction {
+	if x != nil {
+		return x.LastAction
+	}
+	return SyncAction_SYNC_ACTION_NONE
+}
+
+func (x *FollowerReport) GetLastActionServerTimeMs() int64 {
+	if x != nil {
+		return x.LastActionServerTimeMs
+	}
+	return 0
+}
+
+func (x *FollowerReport) GetSampleServerTimeMs() int64 {
+	if x != nil {
+		return x.SampleServerTimeMs
+	}
+	return 0
+}
+
+type MemberSyncHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId               string     `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName            string     `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	DriftMs                int64      `protobuf:"varint,3,opt,name=drift_ms,json=driftMs,proto3" json:"drift_ms,omitempty"`
+	PositionMs             int64      `protobuf:"varint,4,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Rate                   float64    `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Endpoint               string     `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	LastAction             SyncAction `protobuf:"varint,7,opt,name=last_action,json=lastAction,proto3,enum=videowithyou.SyncAction" json:"last_action,omitempty"`
+	LastActionServerTimeMs int64      `protobuf:"varint,8,opt,name=last_action_server_time_ms,json=lastActionServerTimeMs,proto3" json:"last_action_server_time_ms,omitempty"`
+	// report_age_ms is how old the member's latest report is.
+	ReportAgeMs int64 `protobuf:"varint,9,opt,name=report_age_ms,json=reportAgeMs,proto3" json:"report_age_ms,omitempty"`
+}
+
+func (x *MemberSyncHealth) Reset() {
+	*x = MemberSyncHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberSyncHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSyncHealth) ProtoMessage() {}
+
+func (x *MemberSyncHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSyncHealth.ProtoReflect.Descriptor instead.
+func (*MemberSyncHealth) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemberSyncHealth) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberSyncHealth) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MemberSyncHealth) GetDriftMs() int64 {
+	if x != nil {
+		return x.DriftMs
+	}
+	return 0
+}
+
+func (x *MemberSyncHealth) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *MemberSyncHealth) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *MemberSyncHealth) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *MemberSyncHealth) GetLastAction() SyncAction {
+	if x != nil {
+		return x.LastAction
+	}
+	return SyncAction_SYNC_ACTION_NONE
+}
+
+func (x *MemberSyncHealth) GetLastActionServerTimeMs() int64 {
+	if x != nil {
+		return x.LastActionServerTimeMs
+	}
+	return 0
+}
+
+func (x *MemberSyncHealth) GetReportAgeMs() int64 {
+	if x != nil {
+		return x.ReportAgeMs
+	}
+	return 0
+}
+
+// SyncHealth summarizes follower reports for the host.
+type SyncHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId       string              `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Members      []*MemberSyncHealth `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	ServerTimeMs int64               `protobuf:"varint,3,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+}
+
+func (x *SyncHealth) Reset() {
+	*x = SyncHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncHealth) ProtoMessage() {}
+
+func (x *SyncHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncHealth.ProtoReflect.Descriptor instead.
+func (*SyncHealth) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SyncHealth) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SyncHealth) GetMembers() []*MemberSyncHealth {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SyncHealth) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+type Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId    string `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsHost      bool   `protobuf:"varint,3,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
+	Muted       bool   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Member) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Member) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Member) GetIsHost() bool {
+	if x != nil {
+		return x.IsHost
+	}
+	return false
+}
+
+func (x *Member) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+type MediaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url   string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Site  string            `protobuf:"bytes,3,opt,name=site,proto3" json:"site,omitempty"`
+	Attrs map[string]string `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MediaInfo) Reset() {
+	*x = MediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaInfo) ProtoMessage() {}
+
+func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MediaInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetAttrs() map[string]string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+type HostState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId             string     `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HostId             string     `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Seq                uint64     `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Media              *MediaInfo `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
+	PositionMs         int64      `protobuf:"varint,5,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Rate               float64    `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Paused             bool       `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	SampleServerTimeMs int64      `protobuf:"varint,8,opt,name=sample_server_time_ms,json=sampleServerTimeMs,proto3" json:"sample_server_time_ms,omitempty"`
+	OffsetMs           int64      `protobuf:"varint,9,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+}
+
+func (x *HostState) Reset() {
+	*x = HostState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostState) ProtoMessage() {}
+
+func (x *HostState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostState.ProtoReflect.Descriptor instead.
+func (*HostState) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HostState) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *HostState) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *HostState) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *HostState) GetMedia() *MediaInfo {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *HostState) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *HostState) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *HostState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *HostState) GetSampleServerTimeMs() int64 {
+	if x != nil {
+		return x.SampleServerTimeMs
+	}
+	return 0
+}
+
+func (x *HostState) GetOffsetMs() int64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+type BroadcastState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State        *HostState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ServerTimeMs int64      `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	Members      []*Member  `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *BroadcastState) Reset() {
+	*x = BroadcastState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastState) ProtoMessage() {}
+
+func (x *BroadcastState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastState.ProtoReflect.Descriptor instead.
+func (*BroadcastState) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BroadcastState) GetState() *HostState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *BroadcastState) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *BroadcastState) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RoomSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId       string        `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomCode     string        `protobuf:"bytes,2,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	HostId       string        `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Members      []*Member     `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	LatestState  *HostState    `protobuf:"bytes,5,opt,name=latest_state,json=latestState,proto3" json:"latest_state,omitempty"`
+	ServerTimeMs int64         `protobuf:"varint,6,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	Settings     *RoomSettings `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
+	Premiere     *Premiere     `protobuf:"bytes,8,opt,name=premiere,proto3" json:"premiere,omitempty"`
+	Playlist     *Playlist     `protobuf:"bytes,9,opt,name=playlist,proto3" json:"playlist,omitempty"`
+}
+
+func (x *RoomSnapshot) Reset() {
+	*x = RoomSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSnapshot) ProtoMessage() {}
+
+func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSnapshot.ProtoReflect.Descriptor instead.
+func (*RoomSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RoomSnapshot) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomSnapshot) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *RoomSnapshot) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *RoomSnapshot) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetLatestState() *HostState {
+	if x != nil {
+		return x.LatestState
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *RoomSnapshot) GetSettings() *RoomSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetPremiere() *Premiere {
+	if x != nil {
+		return x.Premiere
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+type TimeSyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T1LocalMs int64 `protobuf:"varint,1,opt,name=t1_local_ms,json=t1LocalMs,proto3" json:"t1_local_ms,omitempty"`
+}
+
+func (x *TimeSyncReq) Reset() {
+	*x = TimeSyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSyncReq) ProtoMessage() {}
+
+func (x *TimeSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSyncReq.ProtoReflect.Descriptor instead.
+func (*TimeSyncReq) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TimeSyncReq) GetT1LocalMs() int64 {
+	if x != nil {
+		return x.T1LocalMs
+	}
+	return 0
+}
+
+type TimeSyncResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T1LocalMs    int64 `protobuf:"varint,1,opt,name=t1_local_ms,json=t1LocalMs,proto3" json:"t1_local_ms,omitempty"`
+	T2ServerMs   int64 `protobuf:"varint,2,opt,name=t2_server_ms,json=t2ServerMs,proto3" json:"t2_server_ms,omitempty"`
+	T3ServerMs   int64 `protobuf:"varint,3,opt,name=t3_server_ms,json=t3ServerMs,proto3" json:"t3_server_ms,omitempty"`
+	ServerTimeMs int64 `protobuf:"varint,4,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+}
+
+func (x *TimeSyncResp) Reset() {
+	*x = TimeSyncResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSyncResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSyncResp) ProtoMessage() {}
+
+func (x *TimeSyncResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSyncResp.ProtoReflect.Descriptor instead.
+func (*TimeSyncResp) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TimeSyncResp) GetT1LocalMs() int64 {
+	if x != nil {
+		return x.T1LocalMs
+	}
+	return 0
+}
+
+func (x *TimeSyncResp) GetT2ServerMs() int64 {
+	if x != nil {
+		return x.T2ServerMs
+	}
+	return 0
+}
+
+func (x *TimeSyncResp) GetT3ServerMs() int64 {
+	if x != nil {
+		return x.T3ServerMs
+	}
+	return 0
+}
+
+func (x *TimeSyncResp) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+type ErrorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Human-readable detail; clients should branch on code instead.
+	Message      string    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ServerTimeMs int64     `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	Code         ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=videowithyou.ErrorCode" json:"code,omitempty"`
+	// request_id of the request that failed, if any.
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *ErrorResp) Reset() {
+	*x = ErrorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResp) ProtoMessage() {}
+
+func (x *ErrorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResp.ProtoReflect.Descriptor instead.
+func (*ErrorResp) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ErrorResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorResp) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *ErrorResp) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *ErrorResp) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// ServerNotice is an operator message shown to every connected client.
+type ServerNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ServerTimeMs int64  `protobuf:"varint,2,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`
+	// Set when the server is about to go away; clients should wait this long
+	// before reconnecting.
+	ReconnectAfterMs int64 `protobuf:"varint,3,opt,name=reconnect_after_ms,json=reconnectAfterMs,proto3" json:"reconnect_after_ms,omitempty"`
+}
+
+func (x *ServerNotice) Reset() {
+	*x = ServerNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_videowithyou_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerNotice) ProtoMessage() {}
+
+func (x *ServerNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_videowithyou_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerNotice.ProtoReflect.Descriptor instead.
+func (*ServerNotice) Descriptor() ([]byte, []int) {
+	return file_proto_videowithyou_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ServerNotice) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ServerNotice) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *ServerNotice) GetReconnectAfterMs() int64 {
+	if x != nil {
+		return x.ReconnectAfterMs
+	}
+	return 0
+}
+
+var File_proto_videowithyou_proto protoreflect.FileDescriptor
+
+var file_proto_videowithyou_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
+	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x22, 0x9f, 0x14, 0x0a, 0x08, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x45, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x48, 0x0a, 0x10,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x6a,
+	0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0e, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79,
+	0x6f, 0x75, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x48,
+	0x00, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12,
+	0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0f,
+	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
+	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f,
+	0x75, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x4b, 0x0a, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00,
+	0x52, 0x0f, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x51, 0x0a, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x11, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0f, 0x6b, 0x69, 0x63, 0x6b, 0x5f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52,
+	0x0d, 0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x42,
+	0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x61, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x75, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
+	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x5e, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
+	0x79, 0x6f, 0x75, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x15, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x44, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x45, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x48, 0x00,
+	0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12,
+	0x45, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61,
+	0x63, 0x6b, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x44, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79,
+	0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77,
+	0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0e, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79,
+	0x6f, 0x75, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x51, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x48,
+	0x00, 0x52, 0x11, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x45, 0x0a, 0x0f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00,
+	0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0b, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xdc, 0x01,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xb9, 0x02, 0x0a,
+	0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a,
+	0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f,
+	0x75, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6a, 0x6f,
+	0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x4f, 0x6e, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc4, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x4f,
+	0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79,
+	0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x01,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x37, 0x0a, 0x15, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x65, 0x64, 0x69,
+	0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xd6, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x6a, 0x6f, 0x69,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x65, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
+	0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x2d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0x5e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x22, 0xb0, 0x02, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x6f, 0x6f, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0b, 0x6a, 0x6f, 0x69,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x74, 0x22, 0x6f, 0x0a,
+	0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0xb0,
+	0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x22, 0x7d, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x64, 0x0a, 0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x0d, 0x4d,
+	0x75, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x66, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x18,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x66, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
+	0x22, 0x59, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x91, 0x03, 0x0a, 0x0a,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22,
+	0xae, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77,
+	0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x22, 0x7e, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x22, 0xde, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xd2, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x34, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x78, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x35, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77,
+	0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x98, 0x01,
+	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f,
+	0x75, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0xdc, 0x02, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3a, 0x0a, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x31, 0x0a, 0x15,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22,
+	0xd9, 0x02, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
+	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x1a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x4d, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0a,
+	0x53, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
+	0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x22, 0x77, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x69, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x22, 0xbb, 0x01, 0x0a,
+	0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74,
+	0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73,
+	0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x02, 0x0a, 0x09, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x8f, 0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x3a, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69,
+	0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68,
+	0x79, 0x6f, 0x75, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x65, 0x72, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x2d, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x31, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x31, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d,
+	0x73, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x31, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x31, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x32, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x32, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x33, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x33, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x4d, 0x73, 0x2a, 0x56, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x49, 0x4e,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54,
+	0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x7b, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c,
+	0x41, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x03, 0x2a, 0x9b, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x5f,
+	0x4f, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x59,
+	0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x50, 0x5f,
+	0x4d, 0x4f, 0x56, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x4f, 0x50, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x50, 0x5f, 0x41, 0x44, 0x56, 0x41,
+	0x4e, 0x43, 0x45, 0x10, 0x05, 0x2a, 0x53, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x4f, 0x46, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0xff, 0x03, 0x0a, 0x09, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x48, 0x4f,
+	0x53, 0x54, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x48, 0x4f, 0x53,
+	0x54, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x1e, 0x0a,
+	0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x08, 0x12, 0x1d, 0x0a,
+	0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x52, 0x4f, 0x4e,
+	0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x0b, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x20,
+	0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f, 0x4f,
+	0x4d, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x0e,
+	0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x4b, 0x45, 0x57, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x10, 0x42, 0x2a, 0x5a, 0x28,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x77, 0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x3b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x77,
+	0x69, 0x74, 0x68, 0x79, 0x6f, 0x75, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_videowithyou_proto_rawDescOnce sync.Once
+	file_proto_videowithyou_proto_rawDescData = file_proto_videowithyou_proto_rawDesc
+)
+
+func file_proto_videowithyou_proto_rawDescGZIP() []byte {
+	file_proto_videowithyou_proto_rawDescOnce.Do(func() {
+		file_proto_videowithyou_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_videowithyou_proto_rawDescData)
+	})
+	return file_proto_videowithyou_proto_rawDescData
+}
+
+var file_proto_videowithyou_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_videowithyou_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_proto_videowithyou_proto_goTypes = []any{
+	(JoinPolicy)(0),               // 0: videowithyou.JoinPolicy
+	(ControlMode)(0),              // 1: videowithyou.ControlMode
+	(ControlAction)(0),            // 2: videowithyou.ControlAction
+	(PlaylistOp)(0),               // 3: videowithyou.PlaylistOp
+	(SyncAction)(0),               // 4: videowithyou.SyncAction
+	(ErrorCode)(0),                // 5: videowithyou.ErrorCode
+	(*Envelope)(nil),              // 6: videowithyou.Envelope
+	(*ClientHello)(nil),           // 7: videowithyou.ClientHello
+	(*ServerHello)(nil),           // 8: videowithyou.ServerHello
+	(*RoomSettings)(nil),          // 9: videowithyou.RoomSettings
+	(*UpdateRoomSettingsReq)(nil), // 10: videowithyou.UpdateRoomSettingsReq
+	(*CreateRoomReq)(nil),         // 11: videowithyou.CreateRoomReq
+	(*Premiere)(nil),              // 12: videowithyou.Premiere
+	(*CreateRoomResp)(nil),        // 13: videowithyou.CreateRoomResp
+	(*ListRoomsReq)(nil),          // 14: videowithyou.ListRoomsReq
+	(*ListRoomsResp)(nil),         // 15: videowithyou.ListRoomsResp
+	(*PublicRoom)(nil),            // 16: videowithyou.PublicRoom
+	(*JoinRoomReq)(nil),           // 17: videowithyou.JoinRoomReq
+	(*JoinRoomResp)(nil),          // 18: videowithyou.JoinRoomResp
+	(*JoinPendingResp)(nil),       // 19: videowithyou.JoinPendingResp
+	(*JoinRequestNotice)(nil),     // 20: videowithyou.JoinRequestNotice
+	(*JoinDecision)(nil),          // 21: videowithyou.JoinDecision
+	(*KickMemberReq)(nil),         // 22: videowithyou.KickMemberReq
+	(*BanMemberReq)(nil),          // 23: videowithyou.BanMemberReq
+	(*MuteMemberReq)(nil),         // 24: videowithyou.MuteMemberReq
+	(*LeaveRoomReq)(nil),          // 25: videowithyou.LeaveRoomReq
+	(*TransferHostReq)(nil),       // 26: videowithyou.TransferHostReq
+	(*MemberStatus)(nil),          // 27: videowithyou.MemberStatus
+	(*GroupPlayback)(nil),         // 28: videowithyou.GroupPlayback
+	(*ReadyCheckReq)(nil),         // 29: videowithyou.ReadyCheckReq
+	(*ReadyCheckAck)(nil),         // 30: videowithyou.ReadyCheckAck
+	(*ReadyCheck)(nil),            // 31: videowithyou.ReadyCheck
+	(*ControlIntent)(nil),         // 32: videowithyou.ControlIntent
+	(*ControlDecision)(nil),       // 33: videowithyou.ControlDecision
+	(*ChatMessage)(nil),           // 34: videowithyou.ChatMessage
+	(*Reaction)(nil),              // 35: videowithyou.Reaction
+	(*ReactionBatch)(nil),         // 36: videowithyou.ReactionBatch
+	(*Playlist)(nil),              // 37: videowithyou.Playlist
+	(*PlaylistEntry)(nil),         // 38: videowithyou.PlaylistEntry
+	(*PlaylistUpdateReq)(nil),     // 39: videowithyou.PlaylistUpdateReq
+	(*FollowerReport)(nil),        // 40: videowithyou.FollowerReport
+	(*MemberSyncHealth)(nil),      // 41: videowithyou.MemberSyncHealth
+	(*SyncHealth)(nil),            // 42: videowithyou.SyncHealth
+	(*Member)(nil),                // 43: videowithyou.Member
+	(*MediaInfo)(nil),             // 44: videowithyou.MediaInfo
+	(*HostState)(nil),             // 45: videowithyou.HostState
+	(*BroadcastState)(nil),        // 46: videowithyou.BroadcastState
+	(*RoomSnapshot)(nil),          // 47: videowithyou.RoomSnapshot
+	(*TimeSyncReq)(nil),           // 48: videowithyou.TimeSyncReq
+	(*TimeSyncResp)(nil),          // 49: videowithyou.TimeSyncResp
+	(*ErrorResp)(nil),             // 50: videowithyou.ErrorResp
+	(*ServerNotice)(nil),          // 51: videowithyou.ServerNotice
+	nil,                           // 52: videowithyou.MediaInfo.AttrsEntry
+}
+var file_proto_videowithyou_proto_depIdxs = []int32{
+	7,  // 0: videowithyou.Envelope.client_hello:type_name -> videowithyou.ClientHello
+	8,  // 1: videowithyou.Envelope.server_hello:type_name -> videowithyou.ServerHello
+	11, // 2: videowithyou.Envelope.create_room_req:type_name -> videowithyou.CreateRoomReq
+	13, // 3: videowithyou.Envelope.create_room_resp:type_name -> videowithyou.CreateRoomResp
+	17, // 4: videowithyou.Envelope.join_room_req:type_name -> videowithyou.JoinRoomReq
+	18, // 5: videowithyou.Envelope.join_room_resp:type_name -> videowithyou.JoinRoomResp
+	25, // 6: videowithyou.Envelope.leave_room_req:type_name -> videowithyou.LeaveRoomReq
+	47, // 7: videowithyou.Envelope.room_snapshot:type_name -> videowithyou.RoomSnapshot
+	45, // 8: videowithyou.Envelope.host_state:type_name -> videowithyou.HostState
+	46, // 9: videowithyou.Envelope.broadcast_state:type_name -> videowithyou.BroadcastState
+	48, // 10: videowithyou.Envelope.time_sync_req:type_name -> videowithyou.TimeSyncReq
+	49, // 11: videowithyou.Envelope.time_sync_resp:type_name -> videowithyou.TimeSyncResp
+	50, // 12: videowithyou.Envelope.error_resp:type_name -> videowithyou.ErrorResp
+	27, // 13: videowithyou.Envelope.member_status:type_name -> videowithyou.MemberStatus
+	26, // 14: videowithyou.Envelope.transfer_host_req:type_name -> videowithyou.TransferHostReq
+	19, // 15: videowithyou.Envelope.join_pending_resp:type_name -> videowithyou.JoinPendingResp
+	20, // 16: videowithyou.Envelope.join_request_notice:type_name -> videowithyou.JoinRequestNotice
+	21, // 17: videowithyou.Envelope.join_decision:type_name -> videowithyou.JoinDecision
+	22, // 18: videowithyou.Envelope.kick_member_req:type_name -> videowithyou.KickMemberReq
+	23, // 19: videowithyou.Envelope.ban_member_req:type_name -> videowithyou.BanMemberReq
+	24, // 20: videowithyou.Envelope.mute_member_req:type_name -> videowithyou.MuteMemberReq
+	51, // 21: videowithyou.Envelope.server_notice:type_name -> videowithyou.ServerNotice
+	40, // 22: videowithyou.Envelope.follower_report:type_name -> videowithyou.FollowerReport
+	42, // 23: videowithyou.Envelope.sync_health:type_name -> videowithyou.SyncHealth
+	10, // 24: videowithyou.Envelope.update_room_settings_req:type_name -> videowithyou.UpdateRoomSettingsReq
+	28, // 25: videowithyou.Envelope.group_playback:type_name -> videowithyou.GroupPlayback
+	29, // 26: videowithyou.Envelope.ready_check_req:type_name -> videowithyou.ReadyCheckReq
+	30, // 27: videowithyou.Envelope.ready_check_ack:type_name -> videowithyou.ReadyCheckAck
+	31, // 28: videowithyou.Envelope.ready_check:type_name -> videowithyou.ReadyCheck
+	32, // 29: videowithyou.Envelope.control_intent:type_name -> videowithyou.ControlIntent
+	33, // 30: videowithyou.Envelope.control_decision:type_name -> videowithyou.ControlDecision
+	34, // 31: videowithyou.Envelope.chat_message:type_name -> videowithyou.ChatMessage
+	35, // 32: videowithyou.Envelope.reaction:type_name -> videowithyou.Reaction
+	36, // 33: videowithyou.Envelope.reaction_batch:type_name -> videowithyou.ReactionBatch
+	39, // 34: videowithyou.Envelope.playlist_update_req:type_name -> videowithyou.PlaylistUpdateReq
+	14, // 35: videowithyou.Envelope.list_rooms_req:type_name -> videowithyou.ListRoomsReq
+	15, // 36: videowithyou.Envelope.list_rooms_resp:type_name -> videowithyou.ListRoomsResp
+	0,  // 37: videowithyou.RoomSettings.join_policy:type_name -> videowithyou.JoinPolicy
+	1,  // 38: videowithyou.RoomSettings.control_mode:type_name -> videowithyou.ControlMode
+	1,  // 39: videowithyou.UpdateRoomSettingsReq.control_mode:type_name -> videowithyou.ControlMode
+	0,  // 40: videowithyou.CreateRoomReq.join_policy:type_name -> videowithyou.JoinPolicy
+	12, // 41: videowithyou.CreateRoomReq.premiere:type_name -> videowithyou.Premiere
+	44, // 42: videowithyou.Premiere.media:type_name -> videowithyou.MediaInfo
+	16, // 43: videowithyou.ListRoomsResp.rooms:type_name -> videowithyou.PublicRoom
+	0,  // 44: videowithyou.PublicRoom.join_policy:type_name -> videowithyou.JoinPolicy
+	34, // 45: videowithyou.JoinRoomResp.recent_chat:type_name -> videowithyou.ChatMessage
+	2,  // 46: videowithyou.ControlIntent.action:type_name -> videowithyou.ControlAction
+	35, // 47: videowithyou.ReactionBatch.reactions:type_name -> videowithyou.Reaction
+	38, // 48: videowithyou.Playlist.current:type_name -> videowithyou.PlaylistEntry
+	38, // 49: videowithyou.Playlist.entries:type_name -> videowithyou.PlaylistEntry
+	44, // 50: videowithyou.PlaylistEntry.media:type_name -> videowithyou.MediaInfo
+	3,  // 51: videowithyou.PlaylistUpdateReq.op:type_name -> videowithyou.PlaylistOp
+	44, // 52: videowithyou.PlaylistUpdateReq.media:type_name -> videowithyou.MediaInfo
+	4,  // 53: videowithyou.FollowerReport.last_action:type_name -> videowithyou.SyncAction
+	4,  // 54: videowithyou.MemberSyncHealth.last_action:type_name -> videowithyou.SyncAction
+	41, // 55: videowithyou.SyncHealth.members:type_name -> videowithyou.MemberSyncHealth
+	52, // 56: videowithyou.MediaInfo.attrs:type_name -> videowithyou.MediaInfo.AttrsEntry
+	44, // 57: videowithyou.HostState.media:type_name -> videowithyou.MediaInfo
+	45, // 58: videowithyou.BroadcastState.state:type_name -> videowithyou.HostState
+	43, // 59: videowithyou.BroadcastState.members:type_name -> videowithyou.Member
+	43, // 60: videowithyou.RoomSnapshot.members:type_name -> videowithyou.Member
+	45, // 61: videowithyou.RoomSnapshot.latest_state:type_name -> videowithyou.HostState
+	9,  // 62: videowithyou.RoomSnapshot.settings:type_name -> videowithyou.RoomSettings
+	12, // 63: videowithyou.RoomSnapshot.premiere:type_name -> videowithyou.Premiere
+	37, // 64: videowithyou.RoomSnapshot.playlist:type_name -> videowithyou.Playlist
+	5,  // 65: videowithyou.ErrorResp.code:type_name -> videowithyou.ErrorCode
+	66, // [66:66] is the sub-list for method output_type
+	66, // [66:66] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_proto_videowithyou_proto_init() }
+func file_proto_videowithyou_proto_init() {
+	if File_proto_videowithyou_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_videowithyou_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ClientHello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerHello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RoomSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateRoomSettingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Premiere); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRoomResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ClientHello); i {
+		file_proto_videowithyou_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRoomsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1574,8 +5616,8 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ServerHello); i {
+		file_proto_videowithyou_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRoomsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1586,8 +5628,8 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateRoomReq); i {
+		file_proto_videowithyou_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PublicRoom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1598,8 +5640,8 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateRoomResp); i {
+		file_proto_videowithyou_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinRoomReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,8 +5652,8 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinRoomReq); i {
+		file_proto_videowithyou_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinRoomResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,8 +5664,8 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinRoomResp); i {
+		file_proto_videowithyou_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinPendingResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +5676,67 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinRequestNotice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*KickMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*BanMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*MuteMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*LeaveRoomReq); i {
 			case 0:
 				return &v.state
@@ -1646,7 +5748,19 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferHostReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*MemberStatus); i {
 			case 0:
 				return &v.state
@@ -1658,7 +5772,187 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupPlayback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadyCheckReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadyCheckAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ControlIntent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ControlDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*Reaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ReactionBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*Playlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PlaylistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*PlaylistUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*FollowerReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*MemberSyncHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SyncHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_videowithyou_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*Member); i {
 			case 0:
 				return &v.state
@@ -1670,7 +5964,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*MediaInfo); i {
 			case 0:
 				return &v.state
@@ -1682,7 +5976,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*HostState); i {
 			case 0:
 				return &v.state
@@ -1694,7 +5988,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*BroadcastState); i {
 			case 0:
 				return &v.state
@@ -1706,7 +6000,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*RoomSnapshot); i {
 			case 0:
 				return &v.state
@@ -1718,7 +6012,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*TimeSyncReq); i {
 			case 0:
 				return &v.state
@@ -1730,7 +6024,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*TimeSyncResp); i {
 			case 0:
 				return &v.state
@@ -1742,7 +6036,7 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
-		file_proto_videowithyou_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_proto_videowithyou_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*ErrorResp); i {
 			case 0:
 				return &v.state
@@ -1754,6 +6048,18 @@ func file_proto_videowithyou_proto_init() {
 				return nil
 			}
 		}
+		file_proto_videowithyou_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerNotice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_proto_videowithyou_proto_msgTypes[0].OneofWrappers = []any{
 		(*Envelope_ClientHello)(nil),
@@ -1770,19 +6076,44 @@ func file_proto_videowithyou_proto_init() {
 		(*Envelope_TimeSyncResp)(nil),
 		(*Envelope_ErrorResp)(nil),
 		(*Envelope_MemberStatus)(nil),
+		(*Envelope_TransferHostReq)(nil),
+		(*Envelope_JoinPendingResp)(nil),
+		(*Envelope_JoinRequestNotice)(nil),
+		(*Envelope_JoinDecision)(nil),
+		(*Envelope_KickMemberReq)(nil),
+		(*Envelope_BanMemberReq)(nil),
+		(*Envelope_MuteMemberReq)(nil),
+		(*Envelope_ServerNotice)(nil),
+		(*Envelope_FollowerReport)(nil),
+		(*Envelope_SyncHealth)(nil),
+		(*Envelope_UpdateRoomSettingsReq)(nil),
+		(*Envelope_GroupPlayback)(nil),
+		(*Envelope_ReadyCheckReq)(nil),
+		(*Envelope_ReadyCheckAck)(nil),
+		(*Envelope_ReadyCheck)(nil),
+		(*Envelope_ControlIntent)(nil),
+		(*Envelope_ControlDecision)(nil),
+		(*Envelope_ChatMessage)(nil),
+		(*Envelope_Reaction)(nil),
+		(*Envelope_ReactionBatch)(nil),
+		(*Envelope_PlaylistUpdateReq)(nil),
+		(*Envelope_ListRoomsReq)(nil),
+		(*Envelope_ListRoomsResp)(nil),
 	}
+	file_proto_videowithyou_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_videowithyou_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      6,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_videowithyou_proto_goTypes,
 		DependencyIndexes: file_proto_videowithyou_proto_depIdxs,
+		EnumInfos:         file_proto_videowithyou_proto_enumTypes,
 		MessageInfos:      file_proto_videowithyou_proto_msgTypes,
 	}.Build()
 	File_proto_videowithyou_proto = out.File
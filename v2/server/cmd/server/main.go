@@ -1,28 +1,190 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"videowithyou/v2/server/internal/config"
 	"videowithyou/v2/server/internal/server"
 )
 
 func main() {
-	addr := flag.String("addr", ":9012", "listen address")
-	path := flag.String("path", "/ws", "websocket path")
-	hostIdleTimeoutSec := flag.Int("host_idle_timeout_sec", 600, "close room if host idle (seconds)")
+	defaults := config.DefaultConfig()
+	configPath := flag.String("config", "", "config path (flags override its values)")
+	addr := flag.String("addr", defaults.ListenAddr, "listen address")
+	path := flag.String("path", defaults.Path, "websocket path")
+	hostIdleTimeoutSec := flag.Int64("host_idle_timeout_sec", defaults.HostIdleTimeoutSec, "close room if host idle (seconds)")
+	resumeGraceSec := flag.Int64("resume_grace_sec", defaults.ResumeGraceSec, "hold a disconnected member's room slot for resume (seconds, 0 disables)")
+	storeDir := flag.String("store_dir", defaults.StoreDir, "persist rooms in this directory (empty keeps them in memory)")
+	adminToken := flag.String("admin_token", defaults.AdminToken, "bearer token for the /admin/ API (empty disables it)")
+	restoreGraceSec := flag.Int64("restore_grace_sec", defaults.RestoreGraceSec, "hold restored members' room slots after a restart (seconds)")
+	maxConnections := flag.Int("max_connections", defaults.MaxConnections, "max open websocket connections (0 = no limit)")
+	maxMessageBytes := flag.Int64("max_message_bytes", defaults.MaxMessageBytes, "max websocket message size")
+	sendQueueLimit := flag.Int("send_queue_limit", defaults.SendQueueLimit, "disconnect a client with this many messages queued")
+	slowConsumerTimeoutSec := flag.Int64("slow_consumer_timeout_sec", defaults.SlowConsumerTimeoutSec, "disconnect a client whose queue stays backed up this long (seconds)")
+	shutdownReconnectSec := flag.Int64("shutdown_reconnect_sec", defaults.ShutdownReconnectSec, "tell clients to reconnect after this many seconds on shutdown")
+	shutdownTimeoutSec := flag.Int64("shutdown_timeout_sec", defaults.ShutdownTimeoutSec, "max time to drain clients on shutdown (seconds)")
+	reactionExportDir := flag.String("reaction_export_dir", defaults.ReactionExportDir, "write each room's reactions here when it closes (empty disables)")
+	redisAddr := flag.String("redis_addr", defaults.RedisAddr, "share rooms with other instances through this Redis server (host:port, empty runs standalone)")
+	instanceID := flag.String("instance_id", defaults.InstanceID, "this instance's id in the cluster (empty picks a random one)")
+	minProtocolVersion := flag.Uint("min_protocol_version", uint(defaults.MinProtocolVersion), "reject clients older than this protocol version")
+	joinLockoutFailures := flag.Int("join_lockout_failures", defaults.JoinLockoutFailures, "lock an IP out of joining after this many failed joins (0 disables)")
+	joinLockoutWindowSec := flag.Int64("join_lockout_window_sec", defaults.JoinLockoutWindowSec, "count failed joins over this window (seconds)")
+	joinLockoutSec := flag.Int64("join_lockout_sec", defaults.JoinLockoutSec, "how long a join lockout lasts (seconds)")
+	trustForwardedFor := flag.Bool("trust_forwarded_for", defaults.TrustForwardedFor, "take client IPs from X-Forwarded-For (only behind a proxy that sets it)")
 	flag.Parse()
 
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("load config failed: %v", err)
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "addr":
+			cfg.ListenAddr = *addr
+		case "path":
+			cfg.Path = *path
+		case "host_idle_timeout_sec":
+			cfg.HostIdleTimeoutSec = *hostIdleTimeoutSec
+		case "resume_grace_sec":
+			cfg.ResumeGraceSec = *resumeGraceSec
+		case "store_dir":
+			cfg.StoreDir = *storeDir
+		case "admin_token":
+			cfg.AdminToken = *adminToken
+		case "restore_grace_sec":
+			cfg.RestoreGraceSec = *restoreGraceSec
+		case "max_connections":
+			cfg.MaxConnections = *maxConnections
+		case "max_message_bytes":
+			cfg.MaxMessageBytes = *maxMessageBytes
+		case "send_queue_limit":
+			cfg.SendQueueLimit = *sendQueueLimit
+		case "slow_consumer_timeout_sec":
+			cfg.SlowConsumerTimeoutSec = *slowConsumerTimeoutSec
+		case "shutdown_reconnect_sec":
+			cfg.ShutdownReconnectSec = *shutdownReconnectSec
+		case "shutdown_timeout_sec":
+			cfg.ShutdownTimeoutSec = *shutdownTimeoutSec
+		case "reaction_export_dir":
+			cfg.ReactionExportDir = *reactionExportDir
+		case "redis_addr":
+			cfg.RedisAddr = *redisAddr
+		case "instance_id":
+			cfg.InstanceID = *instanceID
+		case "min_protocol_version":
+			cfg.MinProtocolVersion = uint32(*minProtocolVersion)
+		case "join_lockout_failures":
+			cfg.JoinLockoutFailures = *joinLockoutFailures
+		case "join_lockout_window_sec":
+			cfg.JoinLockoutWindowSec = *joinLockoutWindowSec
+		case "join_lockout_sec":
+			cfg.JoinLockoutSec = *joinLockoutSec
+		case "trust_forwarded_for":
+			cfg.TrustForwardedFor = *trustForwardedFor
+		}
+	})
+
 	srv := server.NewServer(log.Default())
-	if *hostIdleTimeoutSec > 0 {
-		srv.SetHostIdleTimeout(time.Duration(*hostIdleTimeoutSec) * time.Second)
+	if cfg.HostIdleTimeoutSec > 0 {
+		srv.SetHostIdleTimeout(time.Duration(cfg.HostIdleTimeoutSec) * time.Second)
+	}
+	srv.SetResumeGrace(time.Duration(cfg.ResumeGraceSec) * time.Second)
+	srv.SetMaxConnections(cfg.MaxConnections)
+	srv.SetMaxMessageBytes(cfg.MaxMessageBytes)
+	srv.SetMinProtocolVersion(cfg.MinProtocolVersion)
+	rateLimits := map[string]config.RateLimit{
+		server.LimitCreate:   cfg.RateLimits.Create,
+		server.LimitJoin:     cfg.RateLimits.Join,
+		server.LimitChat:     cfg.RateLimits.Chat,
+		server.LimitTimeSync: cfg.RateLimits.TimeSync,
+	}
+	for kind, limit := range rateLimits {
+		perConn := server.RateLimit{PerSec: limit.ConnPerSec, Burst: limit.ConnBurst}
+		perIP := server.RateLimit{PerSec: limit.IPPerSec, Burst: limit.IPBurst}
+		if err := srv.SetRateLimit(kind, perConn, perIP); err != nil {
+			log.Fatalf("set rate limit: %v", err)
+		}
+	}
+	srv.SetJoinLockout(cfg.JoinLockoutFailures, time.Duration(cfg.JoinLockoutWindowSec)*time.Second, time.Duration(cfg.JoinLockoutSec)*time.Second)
+	srv.SetTrustForwardedFor(cfg.TrustForwardedFor)
+	srv.SetSendQueueLimits(cfg.SendQueueLimit, time.Duration(cfg.SlowConsumerTimeoutSec)*time.Second)
+	if err := srv.SetReactionExportDir(cfg.ReactionExportDir); err != nil {
+		log.Fatalf("open reaction export dir: %v", err)
+	}
+	if cfg.RedisAddr != "" {
+		broker, err := server.NewRedisBroker(cfg.RedisAddr, log.Default())
+		if err != nil {
+			log.Fatalf("connect redis: %v", err)
+		}
+		if err := srv.SetBroker(broker, cfg.InstanceID); err != nil {
+			log.Fatalf("join cluster: %v", err)
+		}
+	}
+	if cfg.StoreDir != "" {
+		store, err := server.NewFileStore(cfg.StoreDir)
+		if err != nil {
+			log.Fatalf("open room store: %v", err)
+		}
+		srv.SetRoomStore(store)
+		if err := srv.RestoreRooms(time.Duration(cfg.RestoreGraceSec) * time.Second); err != nil {
+			log.Fatalf("restore rooms: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(cfg.Path, srv.HandleWS)
+	mux.HandleFunc(strings.TrimSuffix(cfg.Path, "/")+"/json", srv.HandleWSJSON)
+	mux.HandleFunc(strings.TrimSuffix(cfg.Path, "/")+"/sse", srv.HandleSSE)
+	mux.HandleFunc("/metrics", srv.HandleMetrics)
+	mux.HandleFunc("/healthz", srv.HandleHealthz)
+	mux.HandleFunc("/readyz", srv.HandleReadyz)
+	if cfg.AdminToken != "" {
+		mux.Handle("/admin/", srv.AdminHandler(cfg.AdminToken))
+	}
+	httpServer := &http.Server{Addr: cfg.ListenAddr, Handler: mux}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+	srv.SetReady(true)
+	log.Printf("server listening on %s%s", cfg.ListenAddr, cfg.Path)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-errCh:
+		log.Fatalf("server stopped: %v", err)
+	case sig := <-sigCh:
+		log.Printf("received %s, shutting down", sig)
 	}
-	http.HandleFunc(*path, srv.HandleWS)
 
-    log.Printf("server listening on %s%s", *addr, *path)
-    if err := http.ListenAndServe(*addr, nil); err != nil {
-        log.Fatalf("server stopped: %v", err)
-    }
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeoutSec)*time.Second)
+	defer cancel()
+	// The websocket server goes first: it refuses new connections, notifies
+	// and drains its clients, and closes the SSE streams, whose handlers
+	// http.Server.Shutdown would otherwise wait on. Websocket connections
+	// are hijacked, so after that it only has the listener left to stop.
+	if err := srv.Shutdown(ctx, time.Duration(cfg.ShutdownReconnectSec)*time.Second); err != nil {
+		log.Printf("shutdown: %v", err)
+	}
+	if err := httpServer.Shutdown(ctx); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		log.Printf("http shutdown: %v", err)
+	}
+	if err := srv.CloseStore(); err != nil {
+		log.Printf("close room store: %v", err)
+	}
+	if err := srv.CloseBroker(); err != nil {
+		log.Printf("close broker: %v", err)
+	}
+	log.Printf("server stopped")
 }
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+type Config struct {
+	ListenAddr         string `json:"listen_addr"`
+	Path               string `json:"path"`
+	HostIdleTimeoutSec int64  `json:"host_idle_timeout_sec"`
+	ResumeGraceSec     int64  `json:"resume_grace_sec"`
+	StoreDir           string `json:"store_dir"`
+	RestoreGraceSec    int64  `json:"restore_grace_sec"`
+	AdminToken         string `json:"admin_token"`
+	// MaxConnections caps open websocket connections; 0 means no limit.
+	MaxConnections  int   `json:"max_connections"`
+	MaxMessageBytes int64 `json:"max_message_bytes"`
+	// SendQueueLimit and SlowConsumerTimeoutSec bound how far a client's
+	// outbound queue may back up before the client is disconnected.
+	SendQueueLimit         int   `json:"send_queue_limit"`
+	SlowConsumerTimeoutSec int64 `json:"slow_consumer_timeout_sec"`
+	// ShutdownReconnectSec is the delay clients are told to wait before
+	// reconnecting when the server shuts down.
+	ShutdownReconnectSec int64 `json:"shutdown_reconnect_sec"`
+	ShutdownTimeoutSec   int64 `json:"shutdown_timeout_sec"`
+	// ReactionExportDir receives each room's reactions as JSON when the
+	// room closes; empty disables the export.
+	ReactionExportDir string `json:"reaction_export_dir"`
+	// RedisAddr makes the server share rooms with every instance using the
+	// same Redis server; InstanceID names this instance among them.
+	RedisAddr  string `json:"redis_addr"`
+	InstanceID string `json:"instance_id"`
+	// MinProtocolVersion turns away clients speaking an older protocol.
+	MinProtocolVersion uint32 `json:"min_protocol_version"`
+	// RateLimits bound create, join, chat and time sync requests.
+	RateLimits RateLimits `json:"rate_limits"`
+	// JoinLockoutFailures failed joins from one IP within
+	// JoinLockoutWindowSec lock it out of joining for JoinLockoutSec; 0
+	// disables lockouts.
+	JoinLockoutFailures  int   `json:"join_lockout_failures"`
+	JoinLockoutWindowSec int64 `json:"join_lockout_window_sec"`
+	JoinLockoutSec       int64 `json:"join_lockout_sec"`
+	// TrustForwardedFor takes client IPs from X-Forwarded-For; set it only
+	// behind a reverse proxy that sets the header.
+	TrustForwardedFor bool `json:"trust_forwarded_for"`
+}
+
+type RateLimits struct {
+	Create   RateLimit `json:"create"`
+	Join     RateLimit `json:"join"`
+	Chat     RateLimit `json:"chat"`
+	TimeSync RateLimit `json:"time_sync"`
+}
+
+// RateLimit is one kind of request's token buckets, per connection and per
+// IP: bursts of up to Burst requests, refilled at PerSec a second. A zero
+// rate disables a bucket.
+type RateLimit struct {
+	ConnPerSec float64 `json:"conn_per_sec"`
+	ConnBurst  int     `json:"conn_burst"`
+	IPPerSec   float64 `json:"ip_per_sec"`
+	IPBurst    int     `json:"ip_burst"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		ListenAddr:             ":9012",
+		Path:                   "/ws",
+		HostIdleTimeoutSec:     600,
+		ResumeGraceSec:         30,
+		StoreDir:               "",
+		RestoreGraceSec:        120,
+		AdminToken:             "",
+		MaxConnections:         0,
+		MaxMessageBytes:        2 << 20,
+		SendQueueLimit:         256,
+		SlowConsumerTimeoutSec: 15,
+		ShutdownReconnectSec:   5,
+		ShutdownTimeoutSec:     10,
+		ReactionExportDir:      "",
+		RedisAddr:              "",
+		InstanceID:             "",
+		MinProtocolVersion:     1,
+		RateLimits: RateLimits{
+			Create:   RateLimit{ConnPerSec: 0.1, ConnBurst: 3, IPPerSec: 0.2, IPBurst: 10},
+			Join:     RateLimit{ConnPerSec: 0.5, ConnBurst: 5, IPPerSec: 1, IPBurst: 20},
+			Chat:     RateLimit{ConnPerSec: 0.5, ConnBurst: 5, IPPerSec: 2, IPBurst: 20},
+			TimeSync: RateLimit{ConnPerSec: 2, ConnBurst: 10, IPPerSec: 20, IPBurst: 100},
+		},
+		JoinLockoutFailures:  10,
+		JoinLockoutWindowSec: 60,
+		JoinLockoutSec:       300,
+		TrustForwardedFor:    false,
+	}
+}
+
+// LoadConfig reads path over the defaults. An empty path returns the
+// defaults.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+	if cfg.ListenAddr == "" || cfg.Path == "" {
+		return Config{}, errors.New("listen_addr and path are required")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,280 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const closeReasonAdmin = "admin"
+
+type adminRoom struct {
+	RoomID             string `json:"room_id"`
+	RoomCode           string `json:"room_code"`
+	HostID             string `json:"host_id"`
+	HostName           string `json:"host_name"`
+	JoinPolicy         string `json:"join_policy"`
+	MemberCount        int    `json:"member_count"`
+	PendingCount       int    `json:"pending_count"`
+	LastHostStateAgeMs int64  `json:"last_host_state_age_ms"`
+}
+
+type adminRoomDetail struct {
+	adminRoom
+	Members     []adminMember `json:"members"`
+	Pending     []adminMember `json:"pending"`
+	MediaURL    string        `json:"media_url,omitempty"`
+	MediaTitle  string        `json:"media_title,omitempty"`
+	PositionMs  int64         `json:"position_ms"`
+	Paused      bool          `json:"paused"`
+	Rate        float64       `json:"rate"`
+	LastStateAt int64         `json:"last_state_server_time_ms,omitempty"`
+}
+
+type adminMember struct {
+	MemberID    string `json:"member_id"`
+	DisplayName string `json:"display_name"`
+	IsHost      bool   `json:"is_host"`
+	Active      bool   `json:"active"`
+	Connected   bool   `json:"connected"`
+	Muted       bool   `json:"muted"`
+	JoinedAtMs  int64  `json:"joined_at_ms,omitempty"`
+	// Outbound queue: messages waiting, states coalesced, messages dropped.
+	Queued    int    `json:"queued"`
+	Coalesced uint64 `json:"coalesced"`
+	Dropped   uint64 `json:"dropped"`
+}
+
+type adminCloseReq struct {
+	Reason string `json:"reason"`
+}
+
+type adminNoticeReq struct {
+	Message string `json:"message"`
+}
+
+// AdminHandler serves the admin API. Every request must carry
+// "Authorization: Bearer <token>".
+//
+//	GET  /admin/rooms                  list rooms
+//	GET  /admin/rooms/{room}           room detail; room is an id or a code
+//	GET  /admin/rooms/{room}/reactions the room's reactions by media
+//	POST /admin/rooms/{room}/close     {"reason": "..."}
+//	POST /admin/notice                 {"message": "..."} to every client
+func (s *Server) AdminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/rooms", s.handleAdminListRooms)
+	mux.HandleFunc("GET /admin/rooms/{room}", s.handleAdminRoom)
+	mux.HandleFunc("GET /admin/rooms/{room}/reactions", s.handleAdminReactions)
+	mux.HandleFunc("POST /admin/rooms/{room}/close", s.handleAdminCloseRoom)
+	mux.HandleFunc("POST /admin/notice", s.handleAdminNotice)
+
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if token == "" || subtle.ConstantTimeCompare(got, want) != 1 {
+			writeAdminError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleAdminListRooms(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	s.mu.RLock()
+	rooms := make([]adminRoom, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		rooms = append(rooms, adminRoomLocked(room, now))
+	}
+	s.mu.RUnlock()
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].RoomCode < rooms[j].RoomCode })
+
+	writeAdminJSON(w, http.StatusOK, map[string]any{"rooms": rooms})
+}
+
+func (s *Server) handleAdminRoom(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	s.mu.RLock()
+	room := s.lookupRoomLocked(r.PathValue("room"))
+	if room == nil {
+		s.mu.RUnlock()
+		writeAdminError(w, http.StatusNotFound, "room not found")
+		return
+	}
+	detail := adminRoomDetail{
+		adminRoom: adminRoomLocked(room, now),
+		Members:   make([]adminMember, 0, len(room.members)),
+		Pending:   make([]adminMember, 0, len(room.pending)),
+	}
+	for _, member := range room.members {
+		entry := adminMember{
+			MemberID:    member.id,
+			DisplayName: member.name,
+			IsHost:      member.id == room.hostID,
+			Active:      member.active,
+			Connected:   member.connected,
+			Muted:       isMutedLocked(room, member),
+		}
+		if !member.joinedAt.IsZero() {
+			entry.JoinedAtMs = member.joinedAt.UnixMilli()
+		}
+		entry.Queued, entry.Coalesced, entry.Dropped = member.out.stats()
+		detail.Members = append(detail.Members, entry)
+	}
+	for id, pending := range room.pending {
+		detail.Pending = append(detail.Pending, adminMember{
+			MemberID:    id,
+			DisplayName: pending.client.name,
+			Connected:   pending.client.connected,
+			JoinedAtMs:  pending.requestedAt.UnixMilli(),
+		})
+	}
+	if state := room.latestState; state != nil {
+		detail.MediaURL = state.GetMedia().GetUrl()
+		detail.MediaTitle = state.GetMedia().GetTitle()
+		detail.PositionMs = state.GetPositionMs()
+		detail.Paused = state.GetPaused()
+		detail.Rate = state.GetRate()
+		detail.LastStateAt = state.GetSampleServerTimeMs()
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(detail.Members, func(i, j int) bool { return detail.Members[i].JoinedAtMs < detail.Members[j].JoinedAtMs })
+	sort.Slice(detail.Pending, func(i, j int) bool { return detail.Pending[i].JoinedAtMs < detail.Pending[j].JoinedAtMs })
+	writeAdminJSON(w, http.StatusOK, detail)
+}
+
+func (s *Server) handleAdminReactions(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	room := s.lookupRoomLocked(r.PathValue("room"))
+	if room == nil {
+		s.mu.RUnlock()
+		writeAdminError(w, http.StatusNotFound, "room not found")
+		return
+	}
+	export := reactionExportLocked(room, "", time.Now())
+	s.mu.RUnlock()
+
+	writeAdminJSON(w, http.StatusOK, export)
+}
+
+func (s *Server) handleAdminCloseRoom(w http.ResponseWriter, r *http.Request) {
+	var req adminCloseReq
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeAdminError(w, http.StatusBadRequest, "invalid json")
+			return
+		}
+	}
+	reason := strings.TrimSpace(req.Reason)
+
+	s.mu.Lock()
+	room := s.lookupRoomLocked(r.PathValue("room"))
+	if room == nil {
+		s.mu.Unlock()
+		writeAdminError(w, http.StatusNotFound, "room not found")
+		return
+	}
+	closed := s.closeRoomLocked(room, closeReasonAdmin)
+	s.mu.Unlock()
+
+	message := "room closed by admin"
+	if reason != "" {
+		message += ": " + reason
+	}
+	s.notifyRoomClosed(closed, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_CLOSED_ADMIN, message)
+	s.log.Printf("room closed admin %s reason=%q", closed.id, reason)
+
+	writeAdminJSON(w, http.StatusOK, map[string]any{"room_id": closed.id, "members": len(closed.members)})
+}
+
+func (s *Server) handleAdminNotice(w http.ResponseWriter, r *http.Request) {
+	var req adminNoticeReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		writeAdminError(w, http.StatusBadRequest, "message is empty")
+		return
+	}
+
+	count := s.broadcastNotice(message)
+	s.log.Printf("admin notice sent to %d clients: %q", count, message)
+	writeAdminJSON(w, http.StatusOK, map[string]any{"clients": count})
+}
+
+// broadcastNotice sends a ServerNotice to every connected client and returns
+// how many it was queued for.
+func (s *Server) broadcastNotice(message string) int {
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ServerNotice{
+			ServerNotice: &videowithyoupb.ServerNotice{
+				Message:      message,
+				ServerTimeMs: time.Now().UnixMilli(),
+			},
+		},
+	}
+
+	s.mu.RLock()
+	targets := make([]*Client, 0, len(s.sessions))
+	for _, client := range s.sessions {
+		if client.connected {
+			targets = append(targets, client)
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, client := range targets {
+		_ = s.sendEnvelope(client, env)
+	}
+	return len(targets)
+}
+
+// lookupRoomLocked finds a room by id or code. It is called with s.mu held.
+func (s *Server) lookupRoomLocked(key string) *Room {
+	if room := s.rooms[key]; room != nil {
+		return room
+	}
+	if roomID, ok := s.roomCodes[strings.ToUpper(key)]; ok {
+		return s.rooms[roomID]
+	}
+	return nil
+}
+
+func adminRoomLocked(room *Room, now time.Time) adminRoom {
+	entry := adminRoom{
+		RoomID:       room.id,
+		RoomCode:     room.code,
+		HostID:       room.hostID,
+		JoinPolicy:   room.joinPolicy.String(),
+		MemberCount:  len(room.members),
+		PendingCount: len(room.pending),
+	}
+	if host := room.members[room.hostID]; host != nil {
+		entry.HostName = host.name
+	}
+	if room.latestState != nil {
+		entry.LastHostStateAgeMs = now.Sub(room.lastHostStateAt).Milliseconds()
+	} else {
+		entry.LastHostStateAgeMs = -1
+	}
+	return entry
+}
+
+func writeAdminJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeAdminError(w http.ResponseWriter, status int, message string) {
+	writeAdminJSON(w, status, map[string]string{"error": message})
+}
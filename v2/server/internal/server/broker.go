@@ -0,0 +1,164 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// Broker connects server instances that share rooms. It carries messages
+// between them and resolves room codes cluster-wide.
+type Broker interface {
+	// Publish sends payload to every subscriber of channel, on any
+	// instance.
+	Publish(channel string, payload []byte) error
+	// Subscribe calls handler, one message at a time and in publish order,
+	// for each message published to channel until the broker is closed.
+	Subscribe(channel string, handler func(payload []byte)) error
+	// ClaimRoomCode records instance as the owner of code unless another
+	// instance owns it, and reports whether it does now. A claim with a
+	// positive ttl lapses unless refreshed.
+	ClaimRoomCode(code, instance string, ttl time.Duration) (bool, error)
+	// RefreshRoomCode extends instance's claim on code to ttl, claiming it
+	// again if it lapsed, and reports whether instance owns code. It never
+	// takes a code another instance owns.
+	RefreshRoomCode(code, instance string, ttl time.Duration) (bool, error)
+	// RoomCodeOwner returns the instance that owns code, or "" if none.
+	RoomCodeOwner(code string) (string, error)
+	// ReleaseRoomCode drops instance's claim on code, and leaves another
+	// instance's claim alone.
+	ReleaseRoomCode(code, instance string) error
+	Close() error
+}
+
+var errBrokerClosed = errors.New("broker closed")
+
+// memoryBrokerQueue bounds how many messages a subscriber of a MemoryBroker
+// may fall behind; later ones are dropped.
+const memoryBrokerQueue = 1024
+
+// MemoryBroker is a Broker inside one process. A single server uses its own;
+// servers in one process can share one, e.g. in tests.
+type MemoryBroker struct {
+	log *log.Logger
+
+	mu     sync.Mutex
+	subs   map[string][]chan []byte
+	codes  map[string]memoryClaim
+	closed bool
+}
+
+type memoryClaim struct {
+	instance string
+	// expiresAt is zero for a claim without a ttl.
+	expiresAt time.Time
+}
+
+func NewMemoryBroker(logger *log.Logger) *MemoryBroker {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &MemoryBroker{
+		log:   logger,
+		subs:  make(map[string][]chan []byte),
+		codes: make(map[string]memoryClaim),
+	}
+}
+
+func (m *MemoryBroker) Publish(channel string, payload []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return errBrokerClosed
+	}
+	for _, queue := range m.subs[channel] {
+		select {
+		case queue <- payload:
+		default:
+			m.log.Printf("broker %s: subscriber backed up, message dropped", channel)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryBroker) Subscribe(channel string, handler func(payload []byte)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return errBrokerClosed
+	}
+	queue := make(chan []byte, memoryBrokerQueue)
+	m.subs[channel] = append(m.subs[channel], queue)
+	go func() {
+		for payload := range queue {
+			handler(payload)
+		}
+	}()
+	return nil
+}
+
+func (m *MemoryBroker) ClaimRoomCode(code, instance string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if claim, ok := m.codes[code]; ok && (claim.expiresAt.IsZero() || now.Before(claim.expiresAt)) {
+		return claim.instance == instance, nil
+	}
+	m.codes[code] = newMemoryClaim(instance, ttl, now)
+	return true, nil
+}
+
+func (m *MemoryBroker) RefreshRoomCode(code, instance string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	claim, ok := m.codes[code]
+	if ok && claim.instance != instance && (claim.expiresAt.IsZero() || now.Before(claim.expiresAt)) {
+		return false, nil
+	}
+	m.codes[code] = newMemoryClaim(instance, ttl, now)
+	return true, nil
+}
+
+func (m *MemoryBroker) RoomCodeOwner(code string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	claim, ok := m.codes[code]
+	if !ok || (!claim.expiresAt.IsZero() && time.Now().After(claim.expiresAt)) {
+		return "", nil
+	}
+	return claim.instance, nil
+}
+
+func (m *MemoryBroker) ReleaseRoomCode(code, instance string) error {
+	m.mu.Lock()
+	if m.codes[code].instance == instance {
+		delete(m.codes, code)
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryBroker) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	for _, queues := range m.subs {
+		for _, queue := range queues {
+			close(queue)
+		}
+	}
+	return nil
+}
+
+func newMemoryClaim(instance string, ttl time.Duration, now time.Time) memoryClaim {
+	claim := memoryClaim{instance: instance}
+	if ttl > 0 {
+		claim.expiresAt = now.Add(ttl)
+	}
+	return claim
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// bufferResumeLead is how far ahead a release schedules the shared resume,
+// so every member has the GroupPlayback in hand before it is due.
+const bufferResumeLead = 1500 * time.Millisecond
+
+// bufferHold is a room paused for buffering members.
+type bufferHold struct {
+	positionMs int64
+	rate       float64
+	waiting    []string
+}
+
+// checkBufferHold starts, updates or releases the room's buffering hold to
+// match its members' latest MemberStatus.
+func (s *Server) checkBufferHold(room *Room) {
+	now := time.Now()
+
+	s.mu.Lock()
+	if s.rooms[room.id] != room {
+		s.mu.Unlock()
+		return
+	}
+	var waiting []string
+	if room.pauseOnBuffering {
+		waiting = bufferingMembersLocked(room)
+	}
+
+	var update *videowithyoupb.GroupPlayback
+	switch {
+	case len(waiting) > 0 && room.hold == nil:
+		state := currentStateLocked(room, now)
+		if state == nil || state.Paused || premierePendingLocked(room, now) {
+			// Nothing is playing; a stall here does not hold anyone up.
+			break
+		}
+		room.hold = &bufferHold{
+			positionMs: extrapolatePosition(state, now),
+			rate:       state.Rate,
+			waiting:    waiting,
+		}
+		update = room.hold.playback(room.id, now)
+	case len(waiting) > 0 && !slices.Equal(waiting, room.hold.waiting):
+		room.hold.waiting = waiting
+		update = room.hold.playback(room.id, now)
+	case len(waiting) == 0 && room.hold != nil:
+		update = room.hold.playback(room.id, now)
+		update.Paused = false
+		update.WaitingMemberIds = nil
+		update.ResumeAtServerTimeMs = now.Add(bufferResumeLead).UnixMilli()
+		room.hold = nil
+	}
+	if update == nil {
+		s.mu.Unlock()
+		return
+	}
+	targets := connectedMembersLocked(room)
+	s.mu.Unlock()
+
+	if update.Paused {
+		s.log.Printf("room hold %s position=%d waiting=%v", room.id, update.PositionMs, update.WaitingMemberIds)
+	} else {
+		s.log.Printf("room release %s position=%d resume_at=%d", room.id, update.PositionMs, update.ResumeAtServerTimeMs)
+	}
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_GroupPlayback{GroupPlayback: update},
+	})
+}
+
+func (h *bufferHold) playback(roomID string, now time.Time) *videowithyoupb.GroupPlayback {
+	return &videowithyoupb.GroupPlayback{
+		RoomId:           roomID,
+		Paused:           true,
+		PositionMs:       h.positionMs,
+		Rate:             h.rate,
+		WaitingMemberIds: append([]string(nil), h.waiting...),
+		ServerTimeMs:     now.UnixMilli(),
+	}
+}
+
+// bufferingMembersLocked returns the sorted ids of connected, active members
+// whose player is stalled. It is called with s.mu held.
+func bufferingMembersLocked(room *Room) []string {
+	var ids []string
+	for _, member := range room.members {
+		if member.connected && member.active && member.buffering {
+			ids = append(ids, member.id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// extrapolatePosition returns where a playing state is at now.
+func extrapolatePosition(state *videowithyoupb.HostState, now time.Time) int64 {
+	position := state.PositionMs
+	if elapsed := now.UnixMilli() - state.SampleServerTimeMs; elapsed > 0 && !state.Paused {
+		position += int64(float64(elapsed) * state.Rate)
+	}
+	return position
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"sort"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	// protocolVersion is the newest protocol this server speaks. Protocol 1
+	// is every client from before version negotiation; they send no
+	// protocol_version.
+	protocolVersion       = 2
+	protocolVersionLegacy = 1
+)
+
+const (
+	capResume          = "resume"
+	capChat            = "chat"
+	capReactions       = "reactions"
+	capPlaylist        = "playlist"
+	capDirectory       = "directory"
+	capFollowerReports = "follower_reports"
+	capGroupPlayback   = "group_playback"
+	capSharedControl   = "shared_control"
+)
+
+// legacyCapabilities are assumed for protocol 1 clients. Every capability
+// came with negotiation, so they have none of them.
+var legacyCapabilities = []string{}
+
+// serverCapabilities is what this server supports.
+var serverCapabilities = []string{
+	capResume,
+	capChat,
+	capReactions,
+	capPlaylist,
+	capDirectory,
+	capFollowerReports,
+	capGroupPlayback,
+	capSharedControl,
+}
+
+type capabilitySet map[string]struct{}
+
+func newCapabilitySet(names []string) capabilitySet {
+	set := make(capabilitySet, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+func (c capabilitySet) has(name string) bool {
+	_, ok := c[name]
+	return ok
+}
+
+func (c capabilitySet) list() []string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// negotiate returns the protocol version hello asks for and the
+// capabilities both sides support.
+func negotiate(hello *videowithyoupb.ClientHello) (uint32, capabilitySet) {
+	version := hello.GetProtocolVersion()
+	offered := hello.GetCapabilities()
+	if version == 0 {
+		version = protocolVersionLegacy
+		offered = legacyCapabilities
+	}
+	ours := newCapabilitySet(serverCapabilities)
+	caps := make(capabilitySet, len(offered))
+	for _, name := range offered {
+		if ours.has(name) {
+			caps[name] = struct{}{}
+		}
+	}
+	return version, caps
+}
+
+// envelopeCapability returns the capability a client needs to send or
+// receive env, or "" if every client may.
+func envelopeCapability(env *videowithyoupb.Envelope) string {
+	switch env.Payload.(type) {
+	case *videowithyoupb.Envelope_ChatMessage:
+		return capChat
+	case *videowithyoupb.Envelope_Reaction, *videowithyoupb.Envelope_ReactionBatch:
+		return capReactions
+	case *videowithyoupb.Envelope_PlaylistUpdateReq:
+		return capPlaylist
+	case *videowithyoupb.Envelope_ListRoomsReq, *videowithyoupb.Envelope_ListRoomsResp:
+		return capDirectory
+	case *videowithyoupb.Envelope_FollowerReport, *videowithyoupb.Envelope_SyncHealth:
+		return capFollowerReports
+	case *videowithyoupb.Envelope_GroupPlayback,
+		*videowithyoupb.Envelope_ReadyCheckReq,
+		*videowithyoupb.Envelope_ReadyCheckAck,
+		*videowithyoupb.Envelope_ReadyCheck:
+		return capGroupPlayback
+	case *videowithyoupb.Envelope_ControlIntent, *videowithyoupb.Envelope_ControlDecision:
+		return capSharedControl
+	}
+	return ""
+}
+
+// SetMinProtocolVersion makes the server turn away clients older than
+// version with ERROR_CODE_UPGRADE_REQUIRED.
+func (s *Server) SetMinProtocolVersion(version uint32) {
+	if version == 0 || version > protocolVersion {
+		return
+	}
+	s.minProtocolVersion = version
+}
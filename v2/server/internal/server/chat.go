@@ -0,0 +1,110 @@
+package server
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	// chatHistorySize is how many messages a room keeps for late joiners.
+	chatHistorySize = 50
+	chatMaxRunes    = 500
+)
+
+// chatLog is a fixed-size ring of a room's most recent messages.
+type chatLog struct {
+	entries []*videowithyoupb.ChatMessage
+	next    int
+}
+
+func (l *chatLog) add(msg *videowithyoupb.ChatMessage) {
+	if len(l.entries) < chatHistorySize {
+		l.entries = append(l.entries, msg)
+		return
+	}
+	l.entries[l.next] = msg
+	l.next = (l.next + 1) % chatHistorySize
+}
+
+// recent returns the kept messages, oldest first.
+func (l *chatLog) recent() []*videowithyoupb.ChatMessage {
+	out := make([]*videowithyoupb.ChatMessage, 0, len(l.entries))
+	out = append(out, l.entries[l.next:]...)
+	return append(out, l.entries[:l.next]...)
+}
+
+func (s *Server) handleChatMessage(client *Client, msg *videowithyoupb.ChatMessage) {
+	if msg == nil {
+		return
+	}
+	text := strings.TrimSpace(msg.Text)
+	if text == "" {
+		return
+	}
+	if utf8.RuneCountInString(text) > chatMaxRunes {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, msg.RequestId, "message too long")
+		return
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != msg.RoomId {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_NOT_IN_ROOM, msg.RequestId, "not in room")
+		return
+	}
+	if isMutedLocked(room, client) {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MUTED, msg.RequestId, "muted")
+		return
+	}
+	relay := &videowithyoupb.ChatMessage{
+		RoomId:       room.id,
+		MessageId:    randomID(),
+		MemberId:     client.id,
+		DisplayName:  client.name,
+		Text:         text,
+		ServerTimeMs: now.UnixMilli(),
+	}
+	room.chat.add(relay)
+	targets := make([]*Client, 0, len(room.members))
+	for _, member := range connectedMembersLocked(room) {
+		if member != client {
+			targets = append(targets, member)
+		}
+	}
+	s.mu.Unlock()
+
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ChatMessage{ChatMessage: relay},
+	})
+	// The sender's copy carries its request id so it can match it up.
+	echo := proto.Clone(relay).(*videowithyoupb.ChatMessage)
+	echo.RequestId = msg.RequestId
+	_ = s.sendEnvelope(client, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ChatMessage{ChatMessage: echo},
+	})
+}
+
+// allowSendLocked reports whether another message may be sent now, given
+// the send times in sent, and records it if so. It is called with s.mu held.
+func allowSendLocked(sent *[]time.Time, now time.Time, burst int, window time.Duration) bool {
+	recent := (*sent)[:0]
+	for _, at := range *sent {
+		if now.Sub(at) < window {
+			recent = append(recent, at)
+		}
+	}
+	*sent = recent
+	if len(recent) >= burst {
+		return false
+	}
+	*sent = append(recent, now)
+	return true
+}
@@ -0,0 +1,520 @@
+package server
+
+import (
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// Instances sharing a broker form a cluster. A room lives on the instance
+// that created it (its home); a member connected to another instance (an
+// edge) has its envelopes forwarded to the home, where it is represented by
+// a stub client whose outgoing messages are relayed back to the edge.
+
+const (
+	clusterHeartbeatInterval = 5 * time.Second
+	// clusterInstanceTimeout is how long an instance may go unheard before
+	// its members and rooms are given up on.
+	clusterInstanceTimeout = 15 * time.Second
+	// clusterRoomCodeTTL is how long a room code claim outlives its
+	// instance; claims are refreshed with every heartbeat.
+	clusterRoomCodeTTL = 30 * time.Second
+
+	heartbeatChannel      = "vwy:heartbeat"
+	instanceChannelPrefix = "vwy:instance:"
+)
+
+const (
+	// relayForward carries an envelope from a member's edge to the room's
+	// home.
+	relayForward = "forward"
+	// relayDeliver carries an envelope from the home back to the edge.
+	relayDeliver = "deliver"
+	// relayDisconnect tells the home the member's socket closed.
+	relayDisconnect = "disconnect"
+	// relayDetach tells the home the member moved on to another room.
+	relayDetach = "detach"
+	// relayResume tells the home the member resumed its session on the
+	// edge; relayGone answers when the home no longer holds its slot.
+	relayResume = "resume"
+	relayGone   = "gone"
+	// relayHeartbeat announces an instance to the others; its payload is a
+	// ListRoomsResp with the instance's public rooms.
+	relayHeartbeat = "heartbeat"
+)
+
+type relayMessage struct {
+	Kind     string `json:"kind"`
+	From     string `json:"from"`
+	ClientID string `json:"client_id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Identity string `json:"identity,omitempty"`
+	// IP is the address the member connected from, for join lockouts and
+	// bans on the room's instance.
+	IP       string `json:"ip,omitempty"`
+	Payload  []byte `json:"payload,omitempty"`
+	Coalesce bool   `json:"coalesce,omitempty"`
+	// Capabilities is what the forwarding client negotiated.
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// SetBroker joins the cluster of servers sharing b as instanceID (a random
+// id if empty). It must be called before rooms are restored and before the
+// server starts accepting connections.
+func (s *Server) SetBroker(b Broker, instanceID string) error {
+	if b == nil {
+		return nil
+	}
+	if instanceID == "" {
+		instanceID = randomID()
+	}
+	s.broker = b
+	s.instanceID = instanceID
+	s.codeTTL = clusterRoomCodeTTL
+	if err := b.Subscribe(instanceChannelPrefix+instanceID, s.handleRelay); err != nil {
+		return err
+	}
+	if err := b.Subscribe(heartbeatChannel, s.handleHeartbeat); err != nil {
+		return err
+	}
+	go s.clusterLoop()
+	return nil
+}
+
+// CloseBroker leaves the cluster.
+func (s *Server) CloseBroker() error {
+	return s.broker.Close()
+}
+
+// clusterLoop announces this instance, keeps its room codes claimed and
+// gives up on instances that stopped announcing themselves.
+func (s *Server) clusterLoop() {
+	ticker := time.NewTicker(clusterHeartbeatInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if s.shuttingDown.Load() {
+			return
+		}
+		s.announce()
+
+		s.mu.RLock()
+		codes := make([]string, 0, len(s.roomCodes))
+		for code := range s.roomCodes {
+			codes = append(codes, code)
+		}
+		s.mu.RUnlock()
+		for _, code := range codes {
+			owned, err := s.broker.RefreshRoomCode(code, s.instanceID, s.codeTTL)
+			if err != nil {
+				s.log.Printf("cluster refresh room code %s failed: %v", code, err)
+				break
+			}
+			if !owned {
+				s.log.Printf("cluster room code %s is claimed by another instance; joins by code go there", code)
+			}
+		}
+
+		s.sweepInstances(time.Now())
+	}
+}
+
+// announce publishes this instance's heartbeat.
+func (s *Server) announce() {
+	listing, err := proto.Marshal(&videowithyoupb.ListRoomsResp{Rooms: s.publicRooms(time.Now())})
+	if err != nil {
+		s.log.Printf("cluster encode directory failed: %v", err)
+	}
+	s.publish(heartbeatChannel, relayMessage{Kind: relayHeartbeat, Payload: listing})
+}
+
+func (s *Server) sweepInstances(now time.Time) {
+	s.mu.Lock()
+	dead := make(map[string]bool)
+	for id, seen := range s.instanceSeen {
+		if now.Sub(seen) > clusterInstanceTimeout {
+			dead[id] = true
+			delete(s.instanceSeen, id)
+			delete(s.instanceRooms, id)
+		}
+	}
+	if len(dead) == 0 {
+		s.mu.Unlock()
+		return
+	}
+	stubs := make([]*Client, 0)
+	for id, stub := range s.stubs {
+		if dead[stub.remote] {
+			delete(s.stubs, id)
+			stubs = append(stubs, stub)
+		}
+	}
+	orphans := make([]*Client, 0)
+	for _, client := range s.local {
+		if dead[client.home] {
+			client.home = ""
+			orphans = append(orphans, client)
+		}
+	}
+	for _, client := range s.sessions {
+		// A held session whose room is gone resumes into no room, so the
+		// member rejoins.
+		if !client.connected && dead[client.home] {
+			client.home = ""
+		}
+	}
+	s.mu.Unlock()
+
+	for id := range dead {
+		s.log.Printf("cluster instance %s stopped responding", id)
+	}
+	for _, stub := range stubs {
+		s.cleanupClient(stub)
+	}
+	for _, client := range orphans {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, "", "room closed")
+	}
+}
+
+func (s *Server) handleHeartbeat(payload []byte) {
+	var msg relayMessage
+	if err := json.Unmarshal(payload, &msg); err != nil || msg.From == s.instanceID {
+		return
+	}
+	var listing videowithyoupb.ListRoomsResp
+	if err := proto.Unmarshal(msg.Payload, &listing); err != nil {
+		s.log.Printf("cluster bad directory from %s: %v", msg.From, err)
+	}
+	s.mu.Lock()
+	s.instanceSeen[msg.From] = time.Now()
+	s.instanceRooms[msg.From] = listing.Rooms
+	s.mu.Unlock()
+}
+
+func (s *Server) handleRelay(payload []byte) {
+	var msg relayMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		s.log.Printf("cluster bad relay message: %v", err)
+		return
+	}
+	s.mu.Lock()
+	s.instanceSeen[msg.From] = time.Now()
+	s.mu.Unlock()
+
+	switch msg.Kind {
+	case relayForward:
+		s.handleForward(msg)
+	case relayDeliver:
+		s.mu.RLock()
+		client := s.local[msg.ClientID]
+		// A room the client has since left may still be sending to it.
+		current := client != nil && client.home == msg.From
+		s.mu.RUnlock()
+		if current {
+			s.enqueue(client, msg.Payload, msg.Coalesce)
+		}
+	case relayDisconnect:
+		s.mu.RLock()
+		stub := s.stubs[msg.ClientID]
+		s.mu.RUnlock()
+		if stub == nil || stub.remote != msg.From {
+			return
+		}
+		s.cleanupClient(stub)
+
+		// A held slot keeps its stub, which the member's resumed hello
+		// reattaches.
+		s.mu.Lock()
+		if s.sessions[stub.resumeToken] != stub && s.stubs[stub.id] == stub {
+			delete(s.stubs, stub.id)
+		}
+		s.mu.Unlock()
+	case relayDetach:
+		s.mu.Lock()
+		stub := s.stubs[msg.ClientID]
+		if stub == nil || stub.remote != msg.From {
+			s.mu.Unlock()
+			return
+		}
+		delete(s.stubs, stub.id)
+		delete(s.sessions, stub.resumeToken)
+		s.mu.Unlock()
+
+		s.withdrawPendingJoin(stub)
+		s.removeClientFromRoom(stub, "")
+	case relayResume:
+		s.reattachStub(msg)
+	case relayGone:
+		s.mu.Lock()
+		client := s.local[msg.ClientID]
+		if client == nil || client.home != msg.From {
+			s.mu.Unlock()
+			return
+		}
+		client.home = ""
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, "", "room closed")
+	}
+}
+
+// handleForward handles an envelope from a member connected to another
+// instance, creating its stub on first contact.
+func (s *Server) handleForward(msg relayMessage) {
+	env := &videowithyoupb.Envelope{}
+	if err := proto.Unmarshal(msg.Payload, env); err != nil {
+		s.log.Printf("cluster bad forwarded envelope from %s: %v", msg.From, err)
+		return
+	}
+
+	s.mu.Lock()
+	stub := s.stubs[msg.ClientID]
+	if stub == nil {
+		stub = &Client{
+			id:          msg.ClientID,
+			name:        msg.Name,
+			identity:    msg.Identity,
+			ip:          msg.IP,
+			out:         newOutbox(),
+			active:      true,
+			remote:      msg.From,
+			resumeToken: randomID(),
+			connected:   true,
+			caps:        newCapabilitySet(msg.Capabilities),
+		}
+		s.stubs[stub.id] = stub
+		s.sessions[stub.resumeToken] = stub
+	}
+	s.mu.Unlock()
+
+	if !s.allowRequest(stub, env) {
+		return
+	}
+	s.dispatch(stub, env)
+}
+
+// relayToHome forwards data, read from client's socket, to the instance
+// hosting client's room and reports whether it did. Time sync and the
+// directory, which holds every instance's rooms, are answered locally, and
+// joins and room creation pick their own instance.
+func (s *Server) relayToHome(client *Client, env *videowithyoupb.Envelope, data []byte) bool {
+	switch env.Payload.(type) {
+	case *videowithyoupb.Envelope_TimeSyncReq,
+		*videowithyoupb.Envelope_JoinRoomReq,
+		*videowithyoupb.Envelope_CreateRoomReq,
+		*videowithyoupb.Envelope_ListRoomsReq:
+		return false
+	}
+	s.mu.RLock()
+	home := client.home
+	s.mu.RUnlock()
+	if home == "" {
+		return false
+	}
+	if client.json {
+		// Stubs and relays carry binary envelopes.
+		var err error
+		if data, err = proto.Marshal(env); err != nil {
+			return false
+		}
+	}
+
+	s.forward(client, home, data)
+	switch env.Payload.(type) {
+	case *videowithyoupb.Envelope_ClientHello:
+		// Keep the local name current for later forwards too.
+		return false
+	case *videowithyoupb.Envelope_LeaveRoomReq:
+		s.leaveHome(client)
+	}
+	return true
+}
+
+// routeJoin sends a join for a room hosted on another instance there and
+// reports whether it did; joins for rooms here, or unknown codes, are left
+// to handleJoinRoom.
+func (s *Server) routeJoin(client *Client, req *videowithyoupb.JoinRoomReq) bool {
+	if client.remote != "" {
+		return false
+	}
+	s.mu.RLock()
+	_, here := s.roomCodes[req.RoomCode]
+	home := client.home
+	s.mu.RUnlock()
+
+	owner := ""
+	if !here {
+		var err error
+		if owner, err = s.broker.RoomCodeOwner(req.RoomCode); err != nil {
+			s.log.Printf("cluster room code lookup %s failed: %v", req.RoomCode, err)
+		}
+	}
+	if owner == "" || owner == s.instanceID {
+		s.leaveHome(client)
+		return false
+	}
+	if owner != home {
+		s.leaveHome(client)
+	}
+	data, err := proto.Marshal(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_JoinRoomReq{JoinRoomReq: req},
+	})
+	if err != nil {
+		return false
+	}
+
+	s.mu.Lock()
+	client.home = owner
+	if _, seen := s.instanceSeen[owner]; !seen {
+		s.instanceSeen[owner] = time.Now()
+	}
+	s.mu.Unlock()
+
+	s.log.Printf("cluster join %s routed to %s member=%s", req.RoomCode, owner, client.id)
+	s.forward(client, owner, data)
+	return true
+}
+
+// forward sends data, an envelope from client, to its stub on instance.
+func (s *Server) forward(client *Client, instance string, data []byte) {
+	s.publishRelay(instance, relayMessage{
+		Kind:         relayForward,
+		ClientID:     client.id,
+		Name:         client.name,
+		Identity:     client.identity,
+		IP:           client.ip,
+		Capabilities: client.caps.list(),
+		Payload:      data,
+	})
+}
+
+// leaveHome unbinds client from the instance hosting its room, if any, and
+// has that instance drop its stub.
+func (s *Server) leaveHome(client *Client) {
+	s.mu.Lock()
+	home := client.home
+	client.home = ""
+	s.mu.Unlock()
+	if home != "" {
+		s.publishRelay(home, relayMessage{Kind: relayDetach, ClientID: client.id})
+	}
+}
+
+// resumeAtHome tells the instance holding client's slot that client
+// resumed its session here.
+func (s *Server) resumeAtHome(client *Client) {
+	s.mu.RLock()
+	home := client.home
+	s.mu.RUnlock()
+	if home == "" {
+		return
+	}
+	s.publishRelay(home, relayMessage{
+		Kind:         relayResume,
+		ClientID:     client.id,
+		Name:         client.name,
+		IP:           client.ip,
+		Capabilities: client.caps.list(),
+	})
+}
+
+// reattachStub puts a member that resumed on its edge back into the stub
+// holding its slot, keeping its id, room and role, or tells the edge the
+// slot is gone.
+func (s *Server) reattachStub(msg relayMessage) {
+	s.mu.Lock()
+	stub := s.stubs[msg.ClientID]
+	if stub == nil || stub.remote != msg.From || s.sessions[stub.resumeToken] != stub {
+		s.mu.Unlock()
+		s.publishRelay(msg.From, relayMessage{Kind: relayGone, ClientID: msg.ClientID})
+		return
+	}
+	stub.connected = true
+	stub.active = true
+	stub.ip = msg.IP
+	stub.caps = newCapabilitySet(msg.Capabilities)
+	if msg.Name != "" {
+		stub.name = msg.Name
+	}
+	room := s.rooms[stub.roomID]
+	s.mu.Unlock()
+
+	if room == nil {
+		// Still waiting for approval; the host's answer reaches it as
+		// usual.
+		return
+	}
+	s.log.Printf("client resumed %s room=%s via instance %s", stub.id, room.id, msg.From)
+	s.sendResumedRoom(stub, room)
+}
+
+// registerLocal makes client reachable by relayed deliveries.
+func (s *Server) registerLocal(client *Client) {
+	s.mu.Lock()
+	s.local[client.id] = client
+	s.mu.Unlock()
+}
+
+// forgetLocal undoes registerLocal once client's socket closed and tells
+// the instance hosting its room. client keeps its home in case
+// cleanupClient holds its session for resume.
+func (s *Server) forgetLocal(client *Client) {
+	s.mu.Lock()
+	if s.local[client.id] == client {
+		delete(s.local, client.id)
+	}
+	home := client.home
+	s.mu.Unlock()
+	if home != "" {
+		s.publishRelay(home, relayMessage{Kind: relayDisconnect, ClientID: client.id})
+	}
+}
+
+// deliverRemote sends payload to a stub's member through its instance.
+func (s *Server) deliverRemote(client *Client, payload []byte, coalesce bool) bool {
+	return s.publishRelay(client.remote, relayMessage{
+		Kind:     relayDeliver,
+		ClientID: client.id,
+		Payload:  payload,
+		Coalesce: coalesce,
+	})
+}
+
+func (s *Server) publishRelay(instance string, msg relayMessage) bool {
+	return s.publish(instanceChannelPrefix+instance, msg)
+}
+
+func (s *Server) publish(channel string, msg relayMessage) bool {
+	msg.From = s.instanceID
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return false
+	}
+	if err := s.broker.Publish(channel, data); err != nil {
+		s.log.Printf("cluster publish %s failed: %v", channel, err)
+		return false
+	}
+	return true
+}
+
+// claimRoomCode reserves code across the cluster. When the broker cannot be
+// reached the code is only known to be unique here, which is accepted
+// rather than refusing to create rooms.
+func (s *Server) claimRoomCode(code string) bool {
+	ok, err := s.broker.ClaimRoomCode(code, s.instanceID, s.codeTTL)
+	if err != nil {
+		s.log.Printf("cluster claim room code %s failed: %v", code, err)
+		return true
+	}
+	return ok
+}
+
+func (s *Server) releaseRoomCode(code string) {
+	go func() {
+		if err := s.broker.ReleaseRoomCode(code, s.instanceID); err != nil {
+			s.log.Printf("cluster release room code %s failed: %v", code, err)
+		}
+	}()
+}
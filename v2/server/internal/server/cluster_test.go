@@ -0,0 +1,554 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// testInstance is a Server on a broker shared with the other instances of a
+// test, behind an httptest server.
+type testInstance struct {
+	srv *Server
+	url string
+}
+
+// newTestCluster starts instances ids sharing a MemoryBroker.
+func newTestCluster(t *testing.T, ids ...string) []testInstance {
+	t.Helper()
+	broker := NewMemoryBroker(log.New(io.Discard, "", 0))
+	return newTestClusterOn(t, func() Broker { return broker }, ids...)
+}
+
+// newTestClusterOn starts instances ids, each on the broker newBroker
+// returns.
+func newTestClusterOn(t *testing.T, newBroker func() Broker, ids ...string) []testInstance {
+	t.Helper()
+	logger := log.New(io.Discard, "", 0)
+	instances := make([]testInstance, 0, len(ids))
+	for _, id := range ids {
+		srv := NewServer(logger)
+		srv.SetResumeGrace(0)
+		if err := srv.SetBroker(newBroker(), id); err != nil {
+			t.Fatal(err)
+		}
+		hs := httptest.NewServer(http.HandlerFunc(srv.HandleWS))
+		t.Cleanup(hs.Close)
+		instances = append(instances, testInstance{srv: srv, url: "ws" + strings.TrimPrefix(hs.URL, "http")})
+	}
+	return instances
+}
+
+// testConn is a protocol client connected to one instance.
+type testConn struct {
+	t     *testing.T
+	conn  *websocket.Conn
+	hello *videowithyoupb.ServerHello
+	id    string
+}
+
+func (in testInstance) connect(t *testing.T, name string) *testConn {
+	t.Helper()
+	return in.resume(t, name, "")
+}
+
+// resume connects presenting token from an earlier ServerHello.
+func (in testInstance) resume(t *testing.T, name, token string) *testConn {
+	t.Helper()
+	conn, _, err := websocket.DefaultDialer.Dial(in.url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	c := &testConn{t: t, conn: conn}
+	c.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_ClientHello{ClientHello: &videowithyoupb.ClientHello{
+		ClientName:      name,
+		ResumeToken:     token,
+		ProtocolVersion: protocolVersion,
+		Capabilities:    serverCapabilities,
+	}}})
+	c.hello = c.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetServerHello() != nil }).GetServerHello()
+	c.id = c.hello.ClientId
+	return c
+}
+
+func (c *testConn) send(env *videowithyoupb.Envelope) {
+	c.t.Helper()
+	data, err := proto.Marshal(env)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// waitFor reads envelopes until one matches, failing the test after 3s.
+func (c *testConn) waitFor(match func(*videowithyoupb.Envelope) bool) *videowithyoupb.Envelope {
+	c.t.Helper()
+	_ = c.conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			c.t.Fatalf("waiting for envelope: %v", err)
+		}
+		env := &videowithyoupb.Envelope{}
+		if err := proto.Unmarshal(data, env); err != nil {
+			c.t.Fatal(err)
+		}
+		if match(env) {
+			return env
+		}
+	}
+}
+
+func (c *testConn) createRoom() *videowithyoupb.CreateRoomResp {
+	c.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_CreateRoomReq{CreateRoomReq: &videowithyoupb.CreateRoomReq{RequestId: "create"}}})
+	return c.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetCreateRoomResp() != nil }).GetCreateRoomResp()
+}
+
+func (c *testConn) joinRoom(code string) *videowithyoupb.JoinRoomResp {
+	c.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_JoinRoomReq{JoinRoomReq: &videowithyoupb.JoinRoomReq{RoomCode: code, RequestId: "join"}}})
+	return c.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetJoinRoomResp() != nil }).GetJoinRoomResp()
+}
+
+func TestClusterJoinAcrossInstances(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+
+	member := cluster[1].connect(t, "member")
+	joined := member.joinRoom(created.RoomCode)
+	if joined.RoomId != created.RoomId || joined.HostId != host.id {
+		t.Fatalf("joined %v, want room %s hosted by %s", joined, created.RoomId, host.id)
+	}
+	host.waitFor(func(env *videowithyoupb.Envelope) bool {
+		for _, m := range env.GetRoomSnapshot().GetMembers() {
+			if m.MemberId == member.id && m.DisplayName == "member" {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func TestClusterRelaysBroadcasts(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+	member := cluster[1].connect(t, "member")
+	member.joinRoom(created.RoomCode)
+
+	host.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_HostState{HostState: &videowithyoupb.HostState{
+		RoomId:             created.RoomId,
+		Seq:                1,
+		PositionMs:         5000,
+		Rate:               1,
+		SampleServerTimeMs: time.Now().UnixMilli(),
+	}}})
+	state := member.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetBroadcastState() != nil }).GetBroadcastState().GetState()
+	if state.GetRoomId() != created.RoomId || state.GetPositionMs() < 5000 {
+		t.Fatalf("relayed state %v", state)
+	}
+
+	member.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_ChatMessage{ChatMessage: &videowithyoupb.ChatMessage{RoomId: created.RoomId, Text: "hi"}}})
+	chat := host.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetChatMessage() != nil }).GetChatMessage()
+	if chat.MemberId != member.id || chat.Text != "hi" {
+		t.Fatalf("forwarded chat %v", chat)
+	}
+}
+
+func TestClusterRoomCodeConflict(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+
+	// b must not take over a's code, by claim or by refresh, nor drop it.
+	broker := cluster[1].srv.broker
+	if ok, err := broker.ClaimRoomCode(created.RoomCode, "b", time.Minute); err != nil || ok {
+		t.Fatalf("claim by b = %t, %v", ok, err)
+	}
+	if ok, err := broker.RefreshRoomCode(created.RoomCode, "b", time.Minute); err != nil || ok {
+		t.Fatalf("refresh by b = %t, %v", ok, err)
+	}
+	if err := broker.ReleaseRoomCode(created.RoomCode, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if owner, err := broker.RoomCodeOwner(created.RoomCode); err != nil || owner != "a" {
+		t.Fatalf("owner = %q, %v", owner, err)
+	}
+
+	member := cluster[1].connect(t, "member")
+	if joined := member.joinRoom(created.RoomCode); joined.RoomId != created.RoomId {
+		t.Fatalf("joined %v, want room %s", joined, created.RoomId)
+	}
+
+	// Once a lets the code go, b may claim it.
+	if err := broker.ReleaseRoomCode(created.RoomCode, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := broker.RefreshRoomCode(created.RoomCode, "b", time.Minute); err != nil || !ok {
+		t.Fatalf("refresh by b after release = %t, %v", ok, err)
+	}
+}
+
+func TestClusterLocksOutRelayedFailedJoins(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	cluster[0].srv.SetJoinLockout(2, time.Minute, time.Minute)
+	host := cluster[0].connect(t, "host")
+	host.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_CreateRoomReq{CreateRoomReq: &videowithyoupb.CreateRoomReq{
+		JoinPolicy: videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD,
+		Password:   "secret",
+	}}})
+	code := host.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetCreateRoomResp() != nil }).GetCreateRoomResp().RoomCode
+
+	member := cluster[1].connect(t, "member")
+	for _, want := range []videowithyoupb.ErrorCode{
+		videowithyoupb.ErrorCode_ERROR_CODE_WRONG_PASSWORD,
+		videowithyoupb.ErrorCode_ERROR_CODE_WRONG_PASSWORD,
+		videowithyoupb.ErrorCode_ERROR_CODE_RATE_LIMITED,
+	} {
+		member.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_JoinRoomReq{JoinRoomReq: &videowithyoupb.JoinRoomReq{RoomCode: code, Password: "guess"}}})
+		got := member.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetErrorResp() != nil }).GetErrorResp()
+		if got.Code != want {
+			t.Fatalf("join error %v, want %v", got, want)
+		}
+	}
+}
+
+func TestClusterResumeOnEdge(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	for _, in := range cluster {
+		in.srv.SetResumeGrace(time.Minute)
+	}
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+	member := cluster[1].connect(t, "member")
+	member.joinRoom(created.RoomCode)
+
+	member.conn.Close()
+	home := cluster[0].srv
+	waitUntil(t, func() bool {
+		home.mu.RLock()
+		defer home.mu.RUnlock()
+		stub := home.stubs[member.id]
+		return stub != nil && !stub.connected
+	})
+
+	resumed := cluster[1].resume(t, "member", member.hello.ResumeToken)
+	if !resumed.hello.Resumed || resumed.id != member.id {
+		t.Fatalf("resume hello %v, want resumed as %s", resumed.hello, member.id)
+	}
+	resumed.waitFor(func(env *videowithyoupb.Envelope) bool {
+		return env.GetRoomSnapshot().GetRoomId() == created.RoomId
+	})
+	resumed.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_ChatMessage{ChatMessage: &videowithyoupb.ChatMessage{RoomId: created.RoomId, Text: "back"}}})
+	chat := host.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetChatMessage() != nil }).GetChatMessage()
+	if chat.MemberId != member.id {
+		t.Fatalf("chat from %s, want %s", chat.MemberId, member.id)
+	}
+	home.mu.RLock()
+	members := len(home.rooms[created.RoomId].members)
+	home.mu.RUnlock()
+	if members != 2 {
+		t.Fatalf("room has %d members, want 2", members)
+	}
+}
+
+func TestClusterResumeAfterSlotExpired(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	cluster[1].srv.SetResumeGrace(time.Minute)
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+	member := cluster[1].connect(t, "member")
+	member.joinRoom(created.RoomCode)
+
+	// a holds no slot, so the member's resume through b finds none there.
+	member.conn.Close()
+	waitUntil(t, func() bool {
+		cluster[0].srv.mu.RLock()
+		defer cluster[0].srv.mu.RUnlock()
+		return cluster[0].srv.stubs[member.id] == nil
+	})
+	resumed := cluster[1].resume(t, "member", member.hello.ResumeToken)
+	got := resumed.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetErrorResp() != nil }).GetErrorResp()
+	if got.Code != videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND {
+		t.Fatalf("resume error %v, want ROOM_NOT_FOUND", got)
+	}
+}
+
+func TestClusterDirectory(t *testing.T) {
+	cluster := newTestCluster(t, "a", "b")
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+	host.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_UpdateRoomSettingsReq{UpdateRoomSettingsReq: &videowithyoupb.UpdateRoomSettingsReq{RoomId: created.RoomId, Public: proto.Bool(true)}}})
+	host.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetRoomSnapshot().GetSettings().GetPublic() })
+
+	cluster[0].srv.announce()
+	waitUntil(t, func() bool {
+		cluster[1].srv.mu.RLock()
+		defer cluster[1].srv.mu.RUnlock()
+		return len(cluster[1].srv.instanceRooms["a"]) == 1
+	})
+	browser := cluster[1].connect(t, "browser")
+	browser.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_ListRoomsReq{ListRoomsReq: &videowithyoupb.ListRoomsReq{RequestId: "list"}}})
+	rooms := browser.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetListRoomsResp() != nil }).GetListRoomsResp().Rooms
+	if len(rooms) != 1 || rooms[0].RoomCode != created.RoomCode {
+		t.Fatalf("directory %v, want room %s", rooms, created.RoomCode)
+	}
+	browser.joinRoom(rooms[0].RoomCode)
+}
+
+func TestRedisBrokerPublishSubscribe(t *testing.T) {
+	redis := startFakeRedis(t)
+	a := newTestRedisBroker(t, redis.addr)
+	b := newTestRedisBroker(t, redis.addr)
+
+	received := make(chan string, 1)
+	if err := b.Subscribe("news", func(payload []byte) { received <- string(payload) }); err != nil {
+		t.Fatal(err)
+	}
+	redis.waitSubscribed(t, "news")
+	if err := a.Publish("news", []byte("hello\r\nworld")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-received:
+		if got != "hello\r\nworld" {
+			t.Fatalf("received %q", got)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestRedisBrokerRoomCodes(t *testing.T) {
+	redis := startFakeRedis(t)
+	a := newTestRedisBroker(t, redis.addr)
+	b := newTestRedisBroker(t, redis.addr)
+
+	if ok, err := a.ClaimRoomCode("ABC", "a", time.Minute); !ok || err != nil {
+		t.Fatalf("claim = %t, %v; want it claimed", ok, err)
+	}
+	if ok, err := a.ClaimRoomCode("ABC", "a", time.Minute); !ok || err != nil {
+		t.Fatalf("reclaim = %t, %v; want it still ours", ok, err)
+	}
+	if ok, err := b.ClaimRoomCode("ABC", "b", time.Minute); ok || err != nil {
+		t.Fatalf("conflicting claim = %t, %v; want it refused", ok, err)
+	}
+	if owner, err := b.RoomCodeOwner("ABC"); owner != "a" || err != nil {
+		t.Fatalf("owner = %q, %v; want a", owner, err)
+	}
+	if ok, err := b.RefreshRoomCode("ABC", "b", time.Minute); ok || err != nil {
+		t.Fatalf("refresh by b = %t, %v; want it refused", ok, err)
+	}
+	if ok, err := a.RefreshRoomCode("ABC", "a", 0); !ok || err != nil {
+		t.Fatalf("refresh by a = %t, %v; want it refreshed", ok, err)
+	}
+	if err := b.ReleaseRoomCode("ABC", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if owner, _ := a.RoomCodeOwner("ABC"); owner != "a" {
+		t.Fatalf("owner after b's release = %q, want a", owner)
+	}
+	if err := a.ReleaseRoomCode("ABC", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := b.ClaimRoomCode("ABC", "b", time.Minute); !ok || err != nil {
+		t.Fatalf("claim after release = %t, %v; want it claimed", ok, err)
+	}
+}
+
+func TestClusterOverRedis(t *testing.T) {
+	redis := startFakeRedis(t)
+	cluster := newTestClusterOn(t, func() Broker { return newTestRedisBroker(t, redis.addr) }, "a", "b")
+	redis.waitSubscribed(t, instanceChannelPrefix+"a", instanceChannelPrefix+"b")
+	host := cluster[0].connect(t, "host")
+	created := host.createRoom()
+
+	member := cluster[1].connect(t, "member")
+	joined := member.joinRoom(created.RoomCode)
+	if joined.RoomId != created.RoomId || joined.HostId != host.id {
+		t.Fatalf("joined %v, want room %s hosted by %s", joined, created.RoomId, host.id)
+	}
+	member.send(&videowithyoupb.Envelope{Payload: &videowithyoupb.Envelope_ChatMessage{ChatMessage: &videowithyoupb.ChatMessage{RoomId: created.RoomId, Text: "hi"}}})
+	chat := host.waitFor(func(env *videowithyoupb.Envelope) bool { return env.GetChatMessage() != nil }).GetChatMessage()
+	if chat.MemberId != member.id || chat.Text != "hi" {
+		t.Fatalf("host got chat %v, want hi from %s", chat, member.id)
+	}
+	member.waitFor(func(env *videowithyoupb.Envelope) bool {
+		return env.GetChatMessage().GetMemberId() == member.id
+	})
+}
+
+func newTestRedisBroker(t *testing.T, addr string) *RedisBroker {
+	t.Helper()
+	b, err := NewRedisBroker(addr, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+// fakeRedis serves the part of the Redis protocol RedisBroker uses, keeping
+// keys in memory without expiry.
+type fakeRedis struct {
+	addr string
+
+	mu          sync.Mutex
+	keys        map[string]string
+	subscribers map[string][]*respConn
+	conns       []*respConn
+}
+
+// startFakeRedis listens on a local port until the test ends.
+func startFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeRedis{addr: ln.Addr().String(), keys: make(map[string]string), subscribers: make(map[string][]*respConn)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c := &respConn{conn: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}
+			f.mu.Lock()
+			f.conns = append(f.conns, c)
+			f.mu.Unlock()
+			go f.serve(c)
+		}
+	}()
+	t.Cleanup(func() {
+		ln.Close()
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, c := range f.conns {
+			c.close()
+		}
+	})
+	return f
+}
+
+// waitSubscribed waits until each of channels has a subscriber.
+func (f *fakeRedis) waitSubscribed(t *testing.T, channels ...string) {
+	t.Helper()
+	waitUntil(t, func() bool {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for _, channel := range channels {
+			if len(f.subscribers[channel]) == 0 {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (f *fakeRedis) serve(c *respConn) {
+	for {
+		reply, err := c.read()
+		if err != nil {
+			return
+		}
+		parts, _ := reply.([]any)
+		args := make([]string, len(parts))
+		for i, part := range parts {
+			data, _ := part.([]byte)
+			args[i] = string(data)
+		}
+		if len(args) == 0 {
+			return
+		}
+		f.reply(c, f.run(c, args))
+	}
+}
+
+// run executes args and returns the encoded reply.
+func (f *fakeRedis) run(c *respConn, args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "SUBSCRIBE":
+		f.subscribers[args[1]] = append(f.subscribers[args[1]], c)
+		return "*3\r\n" + bulk("subscribe") + bulk(args[1]) + ":1\r\n"
+	case "PUBLISH":
+		subscribers := f.subscribers[args[1]]
+		for _, sub := range subscribers {
+			f.reply(sub, "*3\r\n"+bulk("message")+bulk(args[1])+bulk(args[2]))
+		}
+		return fmt.Sprintf(":%d\r\n", len(subscribers))
+	case "SET":
+		if _, taken := f.keys[args[1]]; taken && len(args) > 3 && strings.ToUpper(args[3]) == "NX" {
+			return "$-1\r\n"
+		}
+		f.keys[args[1]] = args[2]
+		return "+OK\r\n"
+	case "GET":
+		value, ok := f.keys[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(value)
+	case "EVAL":
+		key, instance := args[3], args[4]
+		owner, ok := f.keys[key]
+		switch args[1] {
+		case redisRefreshScript:
+			if ok && owner != instance {
+				return ":0\r\n"
+			}
+			f.keys[key] = instance
+			return ":1\r\n"
+		case redisReleaseScript:
+			if ok && owner == instance {
+				delete(f.keys, key)
+				return ":1\r\n"
+			}
+			return ":0\r\n"
+		}
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+func (f *fakeRedis) reply(c *respConn, data string) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, _ = c.w.WriteString(data)
+	_ = c.w.Flush()
+}
+
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+// waitUntil polls cond, failing the test after 3s.
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
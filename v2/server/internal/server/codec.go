@@ -0,0 +1,61 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// Websocket subprotocols. Clients that ask for none get binary protobuf.
+const (
+	subprotocolProto = "videowithyou.proto"
+	subprotocolJSON  = "videowithyou.json"
+)
+
+var (
+	jsonMarshal   = protojson.MarshalOptions{UseProtoNames: true}
+	jsonUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	errWrongFrameType = errors.New("wrong frame type")
+)
+
+// HandleWSJSON serves the same protocol as HandleWS with every Envelope sent
+// as a protojson text frame, whatever subprotocol the client asks for.
+func (s *Server) HandleWSJSON(w http.ResponseWriter, r *http.Request) {
+	s.serveWS(w, r, true)
+}
+
+// decodeEnvelope parses a message read from client's socket. Frames of the
+// other encoding are refused with errWrongFrameType.
+func decodeEnvelope(client *Client, msgType int, data []byte) (*videowithyoupb.Envelope, error) {
+	env := &videowithyoupb.Envelope{}
+	if client.json {
+		if msgType != websocket.TextMessage {
+			return nil, errWrongFrameType
+		}
+		return env, jsonUnmarshal.Unmarshal(data, env)
+	}
+	if msgType != websocket.BinaryMessage {
+		return nil, errWrongFrameType
+	}
+	return env, proto.Unmarshal(data, env)
+}
+
+// encodeFrame turns a queued payload, always binary protobuf so broadcasts
+// are marshaled once, into the message written to client.
+func encodeFrame(client *Client, payload []byte) (int, []byte, error) {
+	if !client.json {
+		return websocket.BinaryMessage, payload, nil
+	}
+	env := &videowithyoupb.Envelope{}
+	if err := proto.Unmarshal(payload, env); err != nil {
+		return 0, nil, err
+	}
+	data, err := jsonMarshal.Marshal(env)
+	return websocket.TextMessage, data, err
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// handleControlIntent forwards a follower's play, pause or seek to the host.
+func (s *Server) handleControlIntent(client *Client, intent *videowithyoupb.ControlIntent) {
+	if intent == nil {
+		return
+	}
+	switch intent.Action {
+	case videowithyoupb.ControlAction_CONTROL_ACTION_PLAY,
+		videowithyoupb.ControlAction_CONTROL_ACTION_PAUSE,
+		videowithyoupb.ControlAction_CONTROL_ACTION_SEEK:
+	default:
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, intent.IntentId, "unknown control action")
+		return
+	}
+
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != intent.RoomId {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_NOT_IN_ROOM, intent.IntentId, "not in room")
+		return
+	}
+	if room.hostID == client.id {
+		// The host drives the room directly.
+		s.mu.Unlock()
+		return
+	}
+	if room.controlMode == videowithyoupb.ControlMode_CONTROL_MODE_HOST_ONLY {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, intent.IntentId, "only the host controls playback")
+		return
+	}
+	if isMutedLocked(room, client) {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MUTED, intent.IntentId, "muted")
+		return
+	}
+	host := room.members[room.hostID]
+	if host == nil || !host.connected {
+		s.mu.Unlock()
+		return
+	}
+	if intent.IntentId == "" {
+		intent.IntentId = randomID()
+	}
+	if intent.PositionMs < 0 {
+		intent.PositionMs = 0
+	}
+	intent.MemberId = client.id
+	intent.DisplayName = client.name
+	intent.RequiresApproval = room.controlMode == videowithyoupb.ControlMode_CONTROL_MODE_REQUEST
+	intent.ServerTimeMs = time.Now().UnixMilli()
+	s.mu.Unlock()
+
+	s.log.Printf("control intent room=%s member=%s action=%s position=%d approval=%t", room.id, client.id, intent.Action, intent.PositionMs, intent.RequiresApproval)
+	_ = s.sendEnvelope(host, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ControlIntent{ControlIntent: intent},
+	})
+}
+
+// handleControlDecision passes the host's answer to a control request on to
+// the member that asked.
+func (s *Server) handleControlDecision(client *Client, decision *videowithyoupb.ControlDecision) {
+	if decision == nil {
+		return
+	}
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, decision.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, decision.IntentId, message)
+		return
+	}
+	target := room.members[decision.MemberId]
+	if target == nil || !target.connected {
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+
+	_ = s.sendEnvelope(target, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ControlDecision{ControlDecision: decision},
+	})
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	roomTitleMaxRunes       = 80
+	roomDescriptionMaxRunes = 500
+	// directoryLimit caps how many rooms a ListRoomsResp carries.
+	directoryLimit = 100
+)
+
+func (s *Server) handleListRooms(client *Client, req *videowithyoupb.ListRoomsReq) {
+	if req == nil {
+		return
+	}
+
+	rooms := s.publicRooms(time.Now())
+	// Other instances' rooms are as of their last heartbeat.
+	s.mu.RLock()
+	for _, listed := range s.instanceRooms {
+		rooms = append(rooms, listed...)
+	}
+	s.mu.RUnlock()
+	rooms = sortDirectory(rooms)
+
+	_ = s.sendEnvelope(client, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ListRoomsResp{
+			ListRoomsResp: &videowithyoupb.ListRoomsResp{
+				RequestId: req.RequestId,
+				Rooms:     rooms,
+			},
+		},
+	})
+}
+
+// publicRooms returns this instance's directory entries.
+func (s *Server) publicRooms(now time.Time) []*videowithyoupb.PublicRoom {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rooms := make([]*videowithyoupb.PublicRoom, 0)
+	for _, room := range s.rooms {
+		if room.public {
+			rooms = append(rooms, publicRoomLocked(room, now))
+		}
+	}
+	return sortDirectory(rooms)
+}
+
+// sortDirectory orders rooms with the most members first and keeps the
+// first directoryLimit of them.
+func sortDirectory(rooms []*videowithyoupb.PublicRoom) []*videowithyoupb.PublicRoom {
+	sort.Slice(rooms, func(i, j int) bool {
+		if rooms[i].MemberCount != rooms[j].MemberCount {
+			return rooms[i].MemberCount > rooms[j].MemberCount
+		}
+		return rooms[i].RoomCode < rooms[j].RoomCode
+	})
+	if len(rooms) > directoryLimit {
+		rooms = rooms[:directoryLimit]
+	}
+	return rooms
+}
+
+// publicRoomLocked returns room's directory entry. It is called with s.mu
+// held.
+func publicRoomLocked(room *Room, now time.Time) *videowithyoupb.PublicRoom {
+	entry := &videowithyoupb.PublicRoom{
+		RoomId:      room.id,
+		RoomCode:    room.code,
+		Title:       room.title,
+		Description: room.description,
+		MemberCount: int32(len(room.members)),
+		JoinPolicy:  room.joinPolicy,
+	}
+	if host := room.members[room.hostID]; host != nil {
+		entry.HostName = host.name
+	}
+	if state := currentStateLocked(room, now); state != nil {
+		entry.MediaTitle = state.GetMedia().GetTitle()
+		entry.Playing = !state.Paused && !premierePendingLocked(room, now)
+	}
+	return entry
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"math"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	minPlaybackRate = 0.25
+	maxPlaybackRate = 4.0
+	// hostClockSkewTolerance is how far a sample's server time may be from
+	// the server clock before the host is asked to resync.
+	hostClockSkewTolerance = 3 * time.Second
+	// clockSkewNoticeInterval limits CLOCK_SKEW errors to one per host per
+	// interval; a skewed host sends a state every tick.
+	clockSkewNoticeInterval = 10 * time.Second
+	// seqResetAfter lets a host whose seq went backwards (a restarted client
+	// or a clock step) recover once it has been rejected this long.
+	seqResetAfter = 5 * time.Second
+)
+
+// sanitizeHostStateLocked checks state against the room's last accepted
+// state and repairs what it can. It returns false for a state that must be
+// dropped (out of order), and the sample skew when it exceeded the tolerance
+// and the host should be told to resync. It is called with s.mu held.
+func (s *Server) sanitizeHostStateLocked(room *Room, host *Client, state *videowithyoupb.HostState, now time.Time) (accept bool, skew time.Duration, notify bool) {
+	state.RoomId = room.id
+	state.HostId = host.id
+
+	repaired := false
+	if state.Seq == 0 {
+		state.Seq = room.lastSeq + 1
+		repaired = true
+	} else if state.Seq <= room.lastSeq && now.Sub(room.lastHostStateAt) < seqResetAfter {
+		s.metrics.hostStatesRejected.Add(1)
+		return false, 0, false
+	}
+	room.lastSeq = state.Seq
+
+	if math.IsNaN(state.Rate) || math.IsInf(state.Rate, 0) || state.Rate <= 0 {
+		state.Rate = 1
+		repaired = true
+	} else if state.Rate < minPlaybackRate {
+		state.Rate = minPlaybackRate
+		repaired = true
+	} else if state.Rate > maxPlaybackRate {
+		state.Rate = maxPlaybackRate
+		repaired = true
+	}
+	if state.PositionMs < 0 {
+		state.PositionMs = 0
+		repaired = true
+	}
+
+	nowMs := now.UnixMilli()
+	if state.SampleServerTimeMs == 0 {
+		state.SampleServerTimeMs = nowMs
+		repaired = true
+	} else {
+		skew = time.Duration(state.SampleServerTimeMs-nowMs) * time.Millisecond
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > hostClockSkewTolerance {
+			// Followers extrapolate from the sample time; a sample stamped
+			// with a bad clock would make them seek. Use our receive time.
+			state.SampleServerTimeMs = nowMs
+			repaired = true
+			if now.Sub(room.skewNotifiedAt) >= clockSkewNoticeInterval {
+				room.skewNotifiedAt = now
+				notify = true
+			}
+		} else {
+			skew = 0
+		}
+	}
+	if repaired {
+		s.metrics.hostStatesRepaired.Add(1)
+	}
+	return true, skew, notify
+}
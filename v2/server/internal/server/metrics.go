@@ -0,0 +1,138 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	closeReasonEmpty    = "empty"
+	closeReasonHostIdle = "host_idle"
+)
+
+// roomMembersBuckets are the upper bounds of the room size histogram.
+var roomMembersBuckets = []int{1, 2, 3, 5, 8, 13, 21, 50}
+
+// metrics holds the server's counters. Gauges such as rooms and members are
+// read from the room map when scraped.
+type metrics struct {
+	connections      atomic.Int64
+	broadcasts       atomic.Uint64
+	broadcastSends   atomic.Uint64
+	sendDropped      atomic.Uint64
+	stateCoalesced   atomic.Uint64
+	slowConsumers    atomic.Uint64
+	timeSyncRequests atomic.Uint64
+	// hostStatesRejected counts out-of-order states dropped;
+	// hostStatesRepaired counts states accepted after a fix-up.
+	hostStatesRejected atomic.Uint64
+	hostStatesRepaired atomic.Uint64
+	// rateLimited counts requests refused by a rate limit or join lockout.
+	rateLimited atomic.Uint64
+
+	mu       sync.Mutex
+	closures map[string]uint64
+}
+
+func (m *metrics) roomClosed(reason string) {
+	m.mu.Lock()
+	if m.closures == nil {
+		m.closures = make(map[string]uint64)
+	}
+	m.closures[reason]++
+	m.mu.Unlock()
+}
+
+// SetReady marks whether /readyz reports the server as ready for traffic.
+func (s *Server) SetReady(ready bool) {
+	s.ready.Store(ready)
+}
+
+// HandleHealthz reports that the process is up.
+func (s *Server) HandleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// HandleReadyz reports whether the server accepts new connections.
+func (s *Server) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if !s.ready.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready\n"))
+		return
+	}
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// HandleMetrics writes the server metrics in the Prometheus text format.
+func (s *Server) HandleMetrics(w http.ResponseWriter, r *http.Request) {
+	// Room sizes are exported as a histogram only: a per-room label would
+	// publish join codes on this unauthenticated endpoint. The admin API
+	// has per-room counts.
+	counts := make([]int, len(roomMembersBuckets))
+	var rooms, members int
+	s.mu.RLock()
+	for _, room := range s.rooms {
+		rooms++
+		members += len(room.members)
+		for i, bound := range roomMembersBuckets {
+			if len(room.members) <= bound {
+				counts[i]++
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	s.metrics.mu.Lock()
+	reasons := make([]string, 0, len(s.metrics.closures))
+	for reason := range s.metrics.closures {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	closures := make([]uint64, len(reasons))
+	for i, reason := range reasons {
+		closures[i] = s.metrics.closures[reason]
+	}
+	s.metrics.mu.Unlock()
+
+	var b strings.Builder
+	writeMetric(&b, "videowithyou_connections", "gauge", "Open websocket connections.", s.metrics.connections.Load())
+	writeMetric(&b, "videowithyou_rooms", "gauge", "Open rooms.", rooms)
+
+	b.WriteString("# HELP videowithyou_room_members Members per open room, including held slots.\n")
+	b.WriteString("# TYPE videowithyou_room_members histogram\n")
+	for i, bound := range roomMembersBuckets {
+		fmt.Fprintf(&b, "videowithyou_room_members_bucket{le=\"%d\"} %d\n", bound, counts[i])
+	}
+	fmt.Fprintf(&b, "videowithyou_room_members_bucket{le=\"+Inf\"} %d\n", rooms)
+	fmt.Fprintf(&b, "videowithyou_room_members_sum %d\n", members)
+	fmt.Fprintf(&b, "videowithyou_room_members_count %d\n", rooms)
+
+	writeMetric(&b, "videowithyou_broadcasts_total", "counter", "Host states broadcast to a room.", s.metrics.broadcasts.Load())
+	writeMetric(&b, "videowithyou_broadcast_sends_total", "counter", "Host states queued to followers.", s.metrics.broadcastSends.Load())
+	writeMetric(&b, "videowithyou_send_dropped_total", "counter", "Messages dropped because a client was disconnected or cut off as a slow consumer.", s.metrics.sendDropped.Load())
+	writeMetric(&b, "videowithyou_state_coalesced_total", "counter", "Host states replaced by a newer one before they were sent.", s.metrics.stateCoalesced.Load())
+	writeMetric(&b, "videowithyou_slow_consumer_disconnects_total", "counter", "Clients disconnected because their send queue backed up.", s.metrics.slowConsumers.Load())
+	writeMetric(&b, "videowithyou_time_sync_requests_total", "counter", "Time sync requests served.", s.metrics.timeSyncRequests.Load())
+	writeMetric(&b, "videowithyou_host_states_rejected_total", "counter", "Host states dropped as out of order.", s.metrics.hostStatesRejected.Load())
+	writeMetric(&b, "videowithyou_host_states_repaired_total", "counter", "Host states accepted after clamping or re-stamping.", s.metrics.hostStatesRepaired.Load())
+	writeMetric(&b, "videowithyou_rate_limited_total", "counter", "Requests refused by a rate limit or join lockout.", s.metrics.rateLimited.Load())
+
+	b.WriteString("# HELP videowithyou_room_closures_total Rooms closed, by reason.\n")
+	b.WriteString("# TYPE videowithyou_room_closures_total counter\n")
+	for i, reason := range reasons {
+		fmt.Fprintf(&b, "videowithyou_room_closures_total{reason=%q} %d\n", reason, closures[i])
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = w.Write([]byte(b.String()))
+}
+
+func writeMetric(b *strings.Builder, name, kind, help string, value any) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", name, help, name, kind, name, value)
+}
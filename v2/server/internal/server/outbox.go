@@ -0,0 +1,204 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	sendQueueLimitDefault      = 256
+	slowConsumerTimeoutDefault = 15 * time.Second
+)
+
+var (
+	errOutboxClosed = errors.New("outbox closed")
+	errSlowConsumer = errors.New("slow consumer")
+)
+
+// outbox is a client's outbound queue. Control messages (responses,
+// snapshots, errors, notices) are kept in order and never dropped; only the
+// newest BroadcastState is kept, since a follower has no use for a
+// superseded one. A client whose queue stays backed up is cut off instead of
+// silently losing control messages.
+type outbox struct {
+	mu      sync.Mutex
+	control [][]byte
+	state   []byte
+	// backedUpSince is when the queue last went from empty to non-empty.
+	backedUpSince time.Time
+	// coalesced counts states replaced before they were written; dropped
+	// counts messages refused or discarded.
+	coalesced uint64
+	dropped   uint64
+
+	closed bool
+	// finishing makes the writer send finalFrame once the queue is empty.
+	finishing  bool
+	finalFrame []byte
+
+	notify chan struct{}
+}
+
+func newOutbox() *outbox {
+	return &outbox{notify: make(chan struct{}, 1)}
+}
+
+// push queues a message. With coalesce set it replaces any queued state
+// message instead of adding to the queue, and reports whether it did.
+func (o *outbox) push(payload []byte, coalesce bool, limit int, timeout time.Duration) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed || o.finishing {
+		o.dropped++
+		return false, errOutboxClosed
+	}
+
+	now := time.Now()
+	if o.emptyLocked() {
+		o.backedUpSince = now
+	} else if len(o.control) >= limit || now.Sub(o.backedUpSince) >= timeout {
+		return false, errSlowConsumer
+	}
+
+	superseded := false
+	if coalesce {
+		if o.state != nil {
+			o.coalesced++
+			superseded = true
+		}
+		o.state = payload
+	} else {
+		o.control = append(o.control, payload)
+	}
+	o.signal()
+	return superseded, nil
+}
+
+// next returns the next message to write. done reports that the writer
+// should send frame as a close message and stop.
+func (o *outbox) next() (payload []byte, frame []byte, done bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil, o.finalFrame, true
+	}
+	if len(o.control) > 0 {
+		payload = o.control[0]
+		o.control[0] = nil
+		o.control = o.control[1:]
+		return payload, nil, false
+	}
+	if o.state != nil {
+		payload = o.state
+		o.state = nil
+		return payload, nil, false
+	}
+	if o.finishing {
+		return nil, o.finalFrame, true
+	}
+	return nil, nil, false
+}
+
+// finish lets the writer flush what is queued and then close with frame.
+func (o *outbox) finish(frame []byte) {
+	o.mu.Lock()
+	if !o.closed && !o.finishing {
+		o.finishing = true
+		o.finalFrame = frame
+	}
+	o.signal()
+	o.mu.Unlock()
+}
+
+// abort discards everything queued and makes the writer close with frame.
+// It returns how many messages were discarded, or -1 if already closed.
+func (o *outbox) abort(frame []byte) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return -1
+	}
+	queued := o.queuedLocked()
+	o.dropped += uint64(queued)
+	o.control = nil
+	o.state = nil
+	o.closed = true
+	o.finalFrame = frame
+	o.signal()
+	return queued
+}
+
+func (o *outbox) close() {
+	o.abort(nil)
+}
+
+func (o *outbox) stats() (queued int, coalesced, dropped uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.queuedLocked(), o.coalesced, o.dropped
+}
+
+func (o *outbox) queuedLocked() int {
+	n := len(o.control)
+	if o.state != nil {
+		n++
+	}
+	return n
+}
+
+func (o *outbox) emptyLocked() bool {
+	return len(o.control) == 0 && o.state == nil
+}
+
+func (o *outbox) signal() {
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+}
+
+// SetSendQueueLimits sets how far a client's outbound queue may back up:
+// limit queued control messages, or any backlog older than timeout, gets the
+// client disconnected.
+func (s *Server) SetSendQueueLimits(limit int, timeout time.Duration) {
+	if limit > 0 {
+		s.sendQueueLimit = limit
+	}
+	if timeout > 0 {
+		s.slowConsumerTimeout = timeout
+	}
+}
+
+// enqueue queues payload for client and reports whether it was accepted.
+// State messages (coalesce set) replace an unsent older state. Messages for
+// a stub are relayed to the instance holding its member's socket.
+func (s *Server) enqueue(client *Client, payload []byte, coalesce bool) bool {
+	if client.remote != "" {
+		return s.deliverRemote(client, payload, coalesce)
+	}
+	superseded, err := client.out.push(payload, coalesce, s.sendQueueLimit, s.slowConsumerTimeout)
+	if superseded {
+		s.metrics.stateCoalesced.Add(1)
+	}
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, errSlowConsumer) {
+		s.disconnectSlowConsumer(client)
+	}
+	s.metrics.sendDropped.Add(1)
+	return false
+}
+
+func (s *Server) disconnectSlowConsumer(client *Client) {
+	queued := client.out.abort(websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "send queue backed up"))
+	if queued < 0 {
+		return
+	}
+	s.metrics.slowConsumers.Add(1)
+	s.metrics.sendDropped.Add(uint64(queued))
+	s.log.Printf("client %s disconnected: slow consumer (%d queued)", client.id, queued)
+}
@@ -0,0 +1,296 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// SetRoomStore replaces the store rooms are persisted to. It must be called
+// before the server starts accepting connections.
+func (s *Server) SetRoomStore(store RoomStore) {
+	if store == nil {
+		return
+	}
+	s.store = store
+}
+
+// RestoreRooms loads rooms from the store. Their members come back as held
+// slots that can be reclaimed with their resume tokens for grace; rooms nobody
+// reclaims are removed when those slots expire.
+func (s *Server) RestoreRooms(grace time.Duration) error {
+	records, err := s.store.Load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(grace)
+	codes := make([]string, 0)
+
+	s.mu.Lock()
+	for _, record := range records {
+		if record.ID == "" || len(record.Members) == 0 || s.rooms[record.ID] != nil {
+			continue
+		}
+		if _, taken := s.roomCodes[record.Code]; taken {
+			continue
+		}
+		room := &Room{
+			id:                  record.ID,
+			code:                record.Code,
+			hostID:              record.HostID,
+			members:             make(map[string]*Client, len(record.Members)),
+			lastHostStateAt:     now,
+			joinPolicy:          videowithyoupb.JoinPolicy(record.JoinPolicy),
+			pauseOnBuffering:    record.PauseOnBuffering,
+			controlMode:         videowithyoupb.ControlMode(record.ControlMode),
+			membersEditPlaylist: record.MembersEditPlaylist,
+			public:              record.Public,
+			title:               record.Title,
+			description:         record.Description,
+			passwordSalt:        record.PasswordSalt,
+			passwordHash:        record.PasswordHash,
+			pending:             make(map[string]*pendingJoin),
+			banned:              make(map[string]struct{}, len(record.Banned)),
+			bannedIPs:           make(map[string]struct{}, len(record.BannedIPs)),
+			muted:               make(map[string]struct{}, len(record.Muted)),
+			persistedAt:         now,
+		}
+		for _, identity := range record.Banned {
+			room.banned[identity] = struct{}{}
+		}
+		for _, ip := range record.BannedIPs {
+			room.bannedIPs[ip] = struct{}{}
+		}
+		for _, identity := range record.Muted {
+			room.muted[identity] = struct{}{}
+		}
+		if len(record.LatestState) > 0 {
+			state := &videowithyoupb.HostState{}
+			if err := proto.Unmarshal(record.LatestState, state); err == nil {
+				room.latestState = state
+			}
+		}
+		if record.PremiereStartMs > 0 {
+			media := &videowithyoupb.MediaInfo{}
+			if err := proto.Unmarshal(record.PremiereMedia, media); err == nil {
+				room.premiere = &premiere{start: time.UnixMilli(record.PremiereStartMs), media: media}
+			}
+		}
+		if len(record.Playlist) > 0 {
+			list := &videowithyoupb.Playlist{}
+			if err := proto.Unmarshal(record.Playlist, list); err == nil {
+				room.playlist = playlist{current: list.Current, entries: list.Entries}
+			}
+		}
+		for _, member := range record.Members {
+			client := &Client{
+				id:          member.ID,
+				name:        member.Name,
+				identity:    member.Identity,
+				out:         newOutbox(),
+				roomID:      room.id,
+				isHost:      member.ID == room.hostID,
+				active:      true,
+				joinedAt:    member.JoinedAt,
+				resumeToken: member.ResumeToken,
+				expiresAt:   expiresAt,
+			}
+			if client.resumeToken == "" {
+				client.resumeToken = randomID()
+			}
+			room.members[client.id] = client
+			s.sessions[client.resumeToken] = client
+		}
+		if room.members[room.hostID] == nil {
+			// The host was not persisted with the room; hand it on as if it
+			// had left.
+			successor := pickSuccessor(room)
+			room.hostID = successor.id
+			successor.isHost = true
+		}
+		s.rooms[room.id] = room
+		s.roomCodes[room.code] = room.id
+		s.armPremiereLocked(room)
+		codes = append(codes, room.code)
+	}
+	s.mu.Unlock()
+
+	for _, code := range codes {
+		if !s.claimRoomCode(code) {
+			s.log.Printf("restored room code %s is claimed by another instance", code)
+		}
+	}
+	if len(codes) > 0 {
+		s.log.Printf("restored %d rooms, holding slots for %s", len(codes), grace)
+	}
+	return nil
+}
+
+// CloseStore writes out queued room changes and closes the room store.
+func (s *Server) CloseStore() error {
+	s.storeQueue.close()
+	return s.store.Close()
+}
+
+// storeQueue holds room writes until storeLoop applies them. Only the latest
+// write per room is kept: a Put carries the whole record, so an older one
+// still waiting is superseded.
+type storeQueue struct {
+	mu      sync.Mutex
+	pending map[string]*RoomRecord // a nil record deletes the room
+	wake    chan struct{}
+	quit    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+func newStoreQueue() *storeQueue {
+	return &storeQueue{
+		pending: make(map[string]*RoomRecord),
+		wake:    make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// push queues record (nil to delete) for roomID.
+func (q *storeQueue) push(roomID string, record *RoomRecord) {
+	q.mu.Lock()
+	q.pending[roomID] = record
+	q.mu.Unlock()
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the queued writes and empties the queue.
+func (q *storeQueue) take() map[string]*RoomRecord {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return nil
+	}
+	pending := q.pending
+	q.pending = make(map[string]*RoomRecord)
+	return pending
+}
+
+// close stops storeLoop once it has applied everything queued so far.
+func (q *storeQueue) close() {
+	q.once.Do(func() { close(q.quit) })
+	<-q.done
+}
+
+// storeLoop applies queued room writes to the store until CloseStore.
+func (s *Server) storeLoop() {
+	defer close(s.storeQueue.done)
+	for {
+		select {
+		case <-s.storeQueue.wake:
+			s.applyStoreWrites()
+		case <-s.storeQueue.quit:
+			s.applyStoreWrites()
+			return
+		}
+	}
+}
+
+func (s *Server) applyStoreWrites() {
+	for roomID, record := range s.storeQueue.take() {
+		if record == nil {
+			if err := s.store.Delete(roomID); err != nil {
+				s.log.Printf("room store delete %s failed: %v", roomID, err)
+			}
+			continue
+		}
+		if err := s.store.Put(*record); err != nil {
+			s.log.Printf("room store put %s failed: %v", roomID, err)
+		}
+	}
+}
+
+// persistRoom writes room to the store if it is still open.
+func (s *Server) persistRoom(room *Room) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rooms[room.id] != room {
+		return
+	}
+	s.putRoomLocked(room)
+}
+
+// putRoomLocked queues room for the store. It is called with s.mu held so
+// the record is consistent and writes for the same room queue in order.
+func (s *Server) putRoomLocked(room *Room) {
+	record := RoomRecord{
+		ID:               room.id,
+		Code:             room.code,
+		HostID:           room.hostID,
+		JoinPolicy:       int32(room.joinPolicy),
+		PauseOnBuffering: room.pauseOnBuffering,
+		ControlMode:      int32(room.controlMode),
+		PasswordSalt:     room.passwordSalt,
+		PasswordHash:     room.passwordHash,
+		Members:          make([]MemberRecord, 0, len(room.members)),
+		UpdatedAt:        time.Now(),
+	}
+	for identity := range room.banned {
+		record.Banned = append(record.Banned, identity)
+	}
+	for ip := range room.bannedIPs {
+		record.BannedIPs = append(record.BannedIPs, ip)
+	}
+	for identity := range room.muted {
+		record.Muted = append(record.Muted, identity)
+	}
+	for _, member := range room.members {
+		record.Members = append(record.Members, MemberRecord{
+			ID:          member.id,
+			Identity:    member.identity,
+			Name:        member.name,
+			JoinedAt:    member.joinedAt,
+			ResumeToken: member.resumeToken,
+		})
+	}
+	if room.latestState != nil {
+		if data, err := proto.Marshal(room.latestState); err == nil {
+			record.LatestState = data
+		}
+	}
+	record.MembersEditPlaylist = room.membersEditPlaylist
+	record.Public = room.public
+	record.Title = room.title
+	record.Description = room.description
+	if room.playlist.current != nil || len(room.playlist.entries) > 0 {
+		if data, err := proto.Marshal(room.playlist.proto()); err == nil {
+			record.Playlist = data
+		}
+	}
+	if room.premiere != nil {
+		if data, err := proto.Marshal(room.premiere.media); err == nil {
+			record.PremiereStartMs = room.premiere.start.UnixMilli()
+			record.PremiereMedia = data
+		}
+	}
+
+	room.persistedAt = record.UpdatedAt
+	s.storeQueue.push(room.id, &record)
+}
+
+// deleteRoomLocked forgets room, which closed for reason. It is called with
+// s.mu held.
+func (s *Server) deleteRoomLocked(room *Room, reason string) {
+	s.metrics.roomClosed(reason)
+	s.exportReactionsLocked(room, reason)
+	stopRoomTimersLocked(room)
+	delete(s.rooms, room.id)
+	delete(s.roomCodes, room.code)
+	s.releaseRoomCode(room.code)
+	s.storeQueue.push(room.id, nil)
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// playlistLimit caps how many entries a room's queue holds.
+const playlistLimit = 200
+
+// playlist is a room's shared queue; current is the entry being played, if
+// it came from the queue.
+type playlist struct {
+	current *videowithyoupb.PlaylistEntry
+	entries []*videowithyoupb.PlaylistEntry
+}
+
+func (p *playlist) proto() *videowithyoupb.Playlist {
+	return &videowithyoupb.Playlist{
+		Current: p.current,
+		Entries: append([]*videowithyoupb.PlaylistEntry(nil), p.entries...),
+	}
+}
+
+func (p *playlist) index(entryID string) int {
+	for i, entry := range p.entries {
+		if entry.EntryId == entryID {
+			return i
+		}
+	}
+	return -1
+}
+
+// take removes the queued entry at i and returns it.
+func (p *playlist) take(i int) *videowithyoupb.PlaylistEntry {
+	entry := p.entries[i]
+	p.entries = append(p.entries[:i], p.entries[i+1:]...)
+	return entry
+}
+
+// isWebURL reports whether raw is an absolute http or https URL. Members'
+// browsers navigate to queued entries, so nothing else may be queued.
+func isWebURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+func (s *Server) handlePlaylistUpdate(client *Client, req *videowithyoupb.PlaylistUpdateReq) {
+	if req == nil {
+		return
+	}
+
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != req.RoomId {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_NOT_IN_ROOM, req.RequestId, "not in room")
+		return
+	}
+	changed, code, message := applyPlaylistOpLocked(room, client, req)
+	if code != videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	if !changed {
+		s.mu.Unlock()
+		return
+	}
+	current := room.playlist.current.GetMedia().GetUrl()
+	queued := len(room.playlist.entries)
+	s.mu.Unlock()
+
+	s.log.Printf("room playlist %s op=%s member=%s current=%q queued=%d", room.id, req.Op, client.id, current, queued)
+	s.broadcastRoomSnapshot(room)
+}
+
+// applyPlaylistOpLocked applies req to room's playlist for client. It
+// reports whether the playlist changed, or the error to send. It is called
+// with s.mu held.
+func applyPlaylistOpLocked(room *Room, client *Client, req *videowithyoupb.PlaylistUpdateReq) (bool, videowithyoupb.ErrorCode, string) {
+	isHost := room.hostID == client.id
+	canEdit := isHost || room.membersEditPlaylist
+	list := &room.playlist
+
+	switch req.Op {
+	case videowithyoupb.PlaylistOp_PLAYLIST_OP_ADD:
+		if !canEdit {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, "not host"
+		}
+		if req.Media == nil || strings.TrimSpace(req.Media.Url) == "" {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "media url is required"
+		}
+		if !isWebURL(strings.TrimSpace(req.Media.Url)) {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "media url must be http or https"
+		}
+		if len(list.entries) >= playlistLimit {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "playlist is full"
+		}
+		media := proto.Clone(req.Media).(*videowithyoupb.MediaInfo)
+		media.Url = strings.TrimSpace(media.Url)
+		list.entries = append(list.entries, &videowithyoupb.PlaylistEntry{
+			EntryId:     randomID(),
+			Media:       media,
+			AddedBy:     client.id,
+			AddedByName: client.name,
+		})
+		return true, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+
+	case videowithyoupb.PlaylistOp_PLAYLIST_OP_REMOVE, videowithyoupb.PlaylistOp_PLAYLIST_OP_MOVE:
+		if !canEdit {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, "not host"
+		}
+		i := list.index(req.EntryId)
+		if i < 0 {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "playlist entry not found"
+		}
+		entry := list.take(i)
+		if req.Op == videowithyoupb.PlaylistOp_PLAYLIST_OP_MOVE {
+			to := min(max(int(req.Index), 0), len(list.entries))
+			list.entries = append(list.entries[:to], append([]*videowithyoupb.PlaylistEntry{entry}, list.entries[to:]...)...)
+		}
+		return true, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+
+	case videowithyoupb.PlaylistOp_PLAYLIST_OP_PLAY:
+		if !isHost {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, "not host"
+		}
+		i := list.index(req.EntryId)
+		if i < 0 {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "playlist entry not found"
+		}
+		list.current = list.take(i)
+		return true, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+
+	case videowithyoupb.PlaylistOp_PLAYLIST_OP_ADVANCE:
+		if !isHost {
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, "not host"
+		}
+		if req.EntryId != list.current.GetEntryId() || len(list.entries) == 0 {
+			// Already advanced past it, or nothing is queued.
+			return false, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+		}
+		list.current = list.take(0)
+		return true, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+	}
+	return false, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "unknown playlist op"
+}
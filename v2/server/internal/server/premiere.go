@@ -0,0 +1,114 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// premiereMaxLead bounds how far ahead a premiere may be scheduled.
+const premiereMaxLead = 30 * 24 * time.Hour
+
+// premiere is a room's scheduled start. timer fires at start to push the
+// synthesized playing state to members.
+type premiere struct {
+	start time.Time
+	media *videowithyoupb.MediaInfo
+	timer *time.Timer
+}
+
+// parsePremiere validates a requested premiere. It returns nil and a reason
+// when the request is unusable.
+func parsePremiere(req *videowithyoupb.Premiere, now time.Time) (*premiere, string) {
+	if req.GetMedia() == nil || strings.TrimSpace(req.GetMedia().GetUrl()) == "" {
+		return nil, "premiere media url required"
+	}
+	start := time.UnixMilli(req.GetStartServerTimeMs())
+	if !start.After(now) {
+		return nil, "premiere start must be in the future"
+	}
+	if start.Sub(now) > premiereMaxLead {
+		return nil, "premiere start too far ahead"
+	}
+	return &premiere{start: start, media: req.GetMedia()}, ""
+}
+
+func (p *premiere) proto() *videowithyoupb.Premiere {
+	return &videowithyoupb.Premiere{
+		StartServerTimeMs: p.start.UnixMilli(),
+		Media:             p.media,
+	}
+}
+
+// premierePendingLocked reports whether the room's premiere has yet to
+// start. It is called with s.mu held.
+func premierePendingLocked(room *Room, now time.Time) bool {
+	return room.premiere != nil && now.Before(room.premiere.start)
+}
+
+// currentStateLocked returns the state followers should align to: the
+// host's latest, or the premiere timeline until the host reports one. It is
+// called with s.mu held.
+func currentStateLocked(room *Room, now time.Time) *videowithyoupb.HostState {
+	if room.latestState != nil || room.premiere == nil {
+		return room.latestState
+	}
+	// Position 0 sampled at the start: followers hold at 0 while the sample
+	// is in the future and extrapolate from it afterwards, so late arrivals
+	// land at the right position.
+	return &videowithyoupb.HostState{
+		RoomId:             room.id,
+		HostId:             room.hostID,
+		Media:              room.premiere.media,
+		Rate:               1,
+		SampleServerTimeMs: room.premiere.start.UnixMilli(),
+	}
+}
+
+// armPremiereLocked schedules the room's premiere start. It is called with
+// s.mu held.
+func (s *Server) armPremiereLocked(room *Room) {
+	p := room.premiere
+	if p == nil {
+		return
+	}
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	wait := time.Until(p.start)
+	if wait < 0 {
+		return
+	}
+	p.timer = time.AfterFunc(wait, func() { s.startPremiere(room) })
+}
+
+// stopRoomTimersLocked stops room's pending premiere start and ready check
+// timeout once it closes. It is called with s.mu held.
+func stopRoomTimersLocked(room *Room) {
+	if room.premiere != nil && room.premiere.timer != nil {
+		room.premiere.timer.Stop()
+	}
+	if room.readyCheck != nil {
+		room.readyCheck.timer.Stop()
+		room.readyCheck = nil
+	}
+}
+
+// startPremiere sends the synthesized playing state when the premiere starts
+// unless the host has already taken over.
+func (s *Server) startPremiere(room *Room) {
+	now := time.Now()
+	s.mu.Lock()
+	if s.rooms[room.id] != room || room.latestState != nil {
+		s.mu.Unlock()
+		return
+	}
+	state := currentStateLocked(room, now)
+	// The host idle timer starts at the premiere, not at room creation.
+	room.lastHostStateAt = now
+	s.mu.Unlock()
+
+	s.log.Printf("premiere started room=%s url=%s", room.id, state.GetMedia().GetUrl())
+	s.broadcastHostState(room, state)
+}
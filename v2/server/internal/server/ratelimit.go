@@ -0,0 +1,295 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// Requests rate limited per connection and per IP.
+const (
+	LimitCreate   = "create"
+	LimitJoin     = "join"
+	LimitChat     = "chat"
+	LimitTimeSync = "time_sync"
+)
+
+const (
+	joinLockoutFailuresDefault = 10
+	joinLockoutWindowDefault   = time.Minute
+	joinLockoutDefault         = 5 * time.Minute
+	// ipIdleTimeout is how long an IP's buckets are kept after its last
+	// request; an idle IP's buckets are full again by then.
+	ipIdleTimeout = 10 * time.Minute
+)
+
+// RateLimit is a token bucket holding Burst requests and refilled at PerSec
+// a second. A zero PerSec disables it.
+type RateLimit struct {
+	PerSec float64
+	Burst  int
+}
+
+var defaultRateLimits = map[string][2]RateLimit{
+	// per connection, per IP
+	LimitCreate:   {{PerSec: 0.1, Burst: 3}, {PerSec: 0.2, Burst: 10}},
+	LimitJoin:     {{PerSec: 0.5, Burst: 5}, {PerSec: 1, Burst: 20}},
+	LimitChat:     {{PerSec: 0.5, Burst: 5}, {PerSec: 2, Burst: 20}},
+	LimitTimeSync: {{PerSec: 2, Burst: 10}, {PerSec: 20, Burst: 100}},
+}
+
+type tokenBucket struct {
+	tokens float64
+	at     time.Time
+}
+
+// refill brings b up to now and reports whether it holds a token.
+func (b *tokenBucket) refill(limit RateLimit, now time.Time) bool {
+	if limit.PerSec <= 0 {
+		return true
+	}
+	burst := float64(max(limit.Burst, 1))
+	if b.at.IsZero() {
+		b.tokens = burst
+	} else {
+		b.tokens = min(burst, b.tokens+now.Sub(b.at).Seconds()*limit.PerSec)
+	}
+	b.at = now
+	return b.tokens >= 1
+}
+
+func (b *tokenBucket) take(limit RateLimit) {
+	if limit.PerSec > 0 {
+		b.tokens--
+	}
+}
+
+// ipState is what the limiter remembers about one IP.
+type ipState struct {
+	buckets  map[string]*tokenBucket
+	lastSeen time.Time
+	// failedJoins holds when recent joins from the IP named no room or the
+	// wrong password; lockedUntil is when a lockout they caused ends.
+	failedJoins []time.Time
+	lockedUntil time.Time
+}
+
+// rateLimiter holds the buckets of every connection and IP. It has its own
+// lock so limiting never waits on s.mu.
+type rateLimiter struct {
+	mu      sync.Mutex
+	perConn map[string]RateLimit
+	perIP   map[string]RateLimit
+	ips     map[string]*ipState
+	sweptAt time.Time
+
+	lockoutFailures int
+	lockoutWindow   time.Duration
+	lockout         time.Duration
+	// trustForwardedFor takes client IPs from X-Forwarded-For.
+	trustForwardedFor bool
+}
+
+func newRateLimiter() *rateLimiter {
+	l := &rateLimiter{
+		perConn:         make(map[string]RateLimit),
+		perIP:           make(map[string]RateLimit),
+		ips:             make(map[string]*ipState),
+		lockoutFailures: joinLockoutFailuresDefault,
+		lockoutWindow:   joinLockoutWindowDefault,
+		lockout:         joinLockoutDefault,
+	}
+	for kind, limits := range defaultRateLimits {
+		l.perConn[kind] = limits[0]
+		l.perIP[kind] = limits[1]
+	}
+	return l
+}
+
+// SetRateLimit sets the buckets each connection and each IP get for kind,
+// one of the Limit constants.
+func (s *Server) SetRateLimit(kind string, perConn, perIP RateLimit) error {
+	if _, ok := defaultRateLimits[kind]; !ok {
+		return fmt.Errorf("unknown rate limit %q", kind)
+	}
+	s.limiter.mu.Lock()
+	s.limiter.perConn[kind] = perConn
+	s.limiter.perIP[kind] = perIP
+	s.limiter.mu.Unlock()
+	return nil
+}
+
+// SetJoinLockout locks an IP out of joining for lockout once failures joins
+// from it failed within window. Zero failures disables lockouts.
+func (s *Server) SetJoinLockout(failures int, window, lockout time.Duration) {
+	if failures < 0 || window < 0 || lockout < 0 {
+		return
+	}
+	s.limiter.mu.Lock()
+	s.limiter.lockoutFailures = failures
+	s.limiter.lockoutWindow = window
+	s.limiter.lockout = lockout
+	s.limiter.mu.Unlock()
+}
+
+// SetTrustForwardedFor takes client IPs from the X-Forwarded-For header the
+// reverse proxy in front of the server adds, instead of the peer address.
+func (s *Server) SetTrustForwardedFor(trust bool) {
+	s.limiter.mu.Lock()
+	s.limiter.trustForwardedFor = trust
+	s.limiter.mu.Unlock()
+}
+
+// clientIP returns the IP r came from.
+func (s *Server) clientIP(r *http.Request) string {
+	s.limiter.mu.Lock()
+	trust := s.limiter.trustForwardedFor
+	s.limiter.mu.Unlock()
+	if forwarded := r.Header.Get("X-Forwarded-For"); trust && forwarded != "" {
+		// The last entry is the one our proxy added; earlier ones are the
+		// client's to forge.
+		hops := strings.Split(forwarded, ",")
+		return strings.TrimSpace(hops[len(hops)-1])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// limitedRequest returns the rate limit kind of env and its request id, or
+// "" if env is not limited.
+func limitedRequest(env *videowithyoupb.Envelope) (string, string) {
+	switch payload := env.Payload.(type) {
+	case *videowithyoupb.Envelope_CreateRoomReq:
+		return LimitCreate, payload.CreateRoomReq.GetRequestId()
+	case *videowithyoupb.Envelope_JoinRoomReq:
+		return LimitJoin, payload.JoinRoomReq.GetRequestId()
+	case *videowithyoupb.Envelope_ChatMessage:
+		return LimitChat, payload.ChatMessage.GetRequestId()
+	case *videowithyoupb.Envelope_TimeSyncReq:
+		return LimitTimeSync, ""
+	}
+	return "", ""
+}
+
+// allowRequest checks env, just read from client's connection, against the
+// rate limits, answering it with ERROR_CODE_RATE_LIMITED if it is over.
+func (s *Server) allowRequest(client *Client, env *videowithyoupb.Envelope) bool {
+	kind, requestID := limitedRequest(env)
+	if kind == "" {
+		return true
+	}
+	if reason := s.limiter.allow(client, kind, time.Now()); reason != "" {
+		s.metrics.rateLimited.Add(1)
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_RATE_LIMITED, requestID, reason)
+		return false
+	}
+	return true
+}
+
+// allow takes a token for kind from client's and its IP's buckets,
+// returning why not if either is empty.
+func (l *rateLimiter) allow(client *Client, kind string, now time.Time) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweepLocked(now)
+
+	ip := l.ipLocked(client.ip, now)
+	if ip != nil && kind == LimitJoin && now.Before(ip.lockedUntil) {
+		return fmt.Sprintf("too many failed joins, try again in %s", ip.lockedUntil.Sub(now).Round(time.Second))
+	}
+	if client.remote != "" {
+		// The member's own instance has charged its buckets; the home only
+		// enforces the lockouts from joins that failed here.
+		return ""
+	}
+
+	if client.limits == nil {
+		client.limits = make(map[string]*tokenBucket)
+	}
+	connBucket := client.limits[kind]
+	if connBucket == nil {
+		connBucket = &tokenBucket{}
+		client.limits[kind] = connBucket
+	}
+	var ipBucket *tokenBucket
+	if ip != nil {
+		ipBucket = ip.buckets[kind]
+		if ipBucket == nil {
+			ipBucket = &tokenBucket{}
+			ip.buckets[kind] = ipBucket
+		}
+	}
+
+	connOK := connBucket.refill(l.perConn[kind], now)
+	ipOK := ipBucket == nil || ipBucket.refill(l.perIP[kind], now)
+	if !connOK || !ipOK {
+		return "too many requests"
+	}
+	connBucket.take(l.perConn[kind])
+	if ipBucket != nil {
+		ipBucket.take(l.perIP[kind])
+	}
+	return ""
+}
+
+// joinFailed records a join from client that named no room or the wrong
+// password, locking its IP out once there are too many.
+func (s *Server) joinFailed(client *Client) {
+	l := s.limiter
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ip := l.ipLocked(client.ip, now)
+	if ip == nil || l.lockoutFailures == 0 {
+		return
+	}
+	recent := ip.failedJoins[:0]
+	for _, at := range ip.failedJoins {
+		if now.Sub(at) < l.lockoutWindow {
+			recent = append(recent, at)
+		}
+	}
+	ip.failedJoins = append(recent, now)
+	if len(ip.failedJoins) >= l.lockoutFailures {
+		ip.failedJoins = ip.failedJoins[:0]
+		ip.lockedUntil = now.Add(l.lockout)
+		s.log.Printf("ip %s locked out of joining for %s", client.ip, l.lockout)
+	}
+}
+
+// ipLocked returns the state of ip, or nil for clients without one, such as
+// members of restored rooms that have not reconnected.
+func (l *rateLimiter) ipLocked(ip string, now time.Time) *ipState {
+	if ip == "" {
+		return nil
+	}
+	state := l.ips[ip]
+	if state == nil {
+		state = &ipState{buckets: make(map[string]*tokenBucket)}
+		l.ips[ip] = state
+	}
+	state.lastSeen = now
+	return state
+}
+
+// sweepLocked forgets IPs that have been idle, and are not locked out, for
+// ipIdleTimeout.
+func (l *rateLimiter) sweepLocked(now time.Time) {
+	if now.Sub(l.sweptAt) < ipIdleTimeout {
+		return
+	}
+	l.sweptAt = now
+	for ip, state := range l.ips {
+		if now.Sub(state.lastSeen) > ipIdleTimeout && now.After(state.lockedUntil) {
+			delete(l.ips, ip)
+		}
+	}
+}
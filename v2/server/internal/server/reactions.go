@@ -0,0 +1,260 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	reactionMaxEmojiRunes = 16
+	reactionMaxTextRunes  = 100
+	// reactionsPerMedia caps what a room keeps for one media; the oldest
+	// reactions make way for new ones. reactionMediaLimit caps how many
+	// media a room keeps reactions for.
+	reactionsPerMedia  = 1000
+	reactionMediaLimit = 50
+	// A member may send reactionRateBurst reactions per reactionRateWindow.
+	reactionRateBurst  = 10
+	reactionRateWindow = 10 * time.Second
+)
+
+// reactionExport is the JSON form of a room's reactions, written when the
+// room closes and served by the admin API.
+type reactionExport struct {
+	RoomID     string                `json:"room_id"`
+	RoomCode   string                `json:"room_code"`
+	ExportedAt int64                 `json:"exported_at_ms"`
+	Reason     string                `json:"reason,omitempty"`
+	Media      []reactionExportMedia `json:"media"`
+}
+
+type reactionExportMedia struct {
+	MediaURL   string                 `json:"media_url"`
+	MediaTitle string                 `json:"media_title,omitempty"`
+	Reactions  []reactionExportRecord `json:"reactions"`
+}
+
+type reactionExportRecord struct {
+	ReactionID   string `json:"reaction_id"`
+	MemberID     string `json:"member_id"`
+	DisplayName  string `json:"display_name"`
+	PositionMs   int64  `json:"position_ms"`
+	Emoji        string `json:"emoji,omitempty"`
+	Text         string `json:"text,omitempty"`
+	ServerTimeMs int64  `json:"server_time_ms"`
+}
+
+// SetReactionExportDir makes the server write each room's reactions to a
+// JSON file in dir when the room closes. An empty dir disables the export.
+func (s *Server) SetReactionExportDir(dir string) error {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	s.reactionExportDir = dir
+	return nil
+}
+
+// mediaKey is the identity reactions are filed under: the media URL, or its
+// title for players that have none.
+func mediaKey(media *videowithyoupb.MediaInfo) string {
+	if url := strings.TrimSpace(media.GetUrl()); url != "" {
+		return url
+	}
+	return strings.TrimSpace(media.GetTitle())
+}
+
+func (s *Server) handleReaction(client *Client, msg *videowithyoupb.Reaction) {
+	if msg == nil {
+		return
+	}
+	emoji := strings.TrimSpace(msg.Emoji)
+	text := strings.TrimSpace(msg.Text)
+	if emoji == "" && text == "" {
+		return
+	}
+	if utf8.RuneCountInString(emoji) > reactionMaxEmojiRunes || utf8.RuneCountInString(text) > reactionMaxTextRunes {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, msg.RequestId, "reaction too long")
+		return
+	}
+	if msg.PositionMs < 0 {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, msg.RequestId, "invalid position")
+		return
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != msg.RoomId {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_NOT_IN_ROOM, msg.RequestId, "not in room")
+		return
+	}
+	if isMutedLocked(room, client) {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MUTED, msg.RequestId, "muted")
+		return
+	}
+	media := currentStateLocked(room, now).GetMedia()
+	key := mediaKey(media)
+	if key == "" {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, msg.RequestId, "nothing is playing")
+		return
+	}
+	if !allowSendLocked(&client.reactionSentAt, now, reactionRateBurst, reactionRateWindow) {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_RATE_LIMITED, msg.RequestId, "sending too fast")
+		return
+	}
+	relay := &videowithyoupb.Reaction{
+		RoomId:       room.id,
+		ReactionId:   randomID(),
+		MemberId:     client.id,
+		DisplayName:  client.name,
+		MediaUrl:     key,
+		MediaTitle:   media.GetTitle(),
+		PositionMs:   msg.PositionMs,
+		Emoji:        emoji,
+		Text:         text,
+		ServerTimeMs: now.UnixMilli(),
+	}
+	storeReactionLocked(room, key, relay)
+	targets := make([]*Client, 0, len(room.members))
+	for _, member := range connectedMembersLocked(room) {
+		if member != client {
+			targets = append(targets, member)
+		}
+	}
+	s.mu.Unlock()
+
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_Reaction{Reaction: relay},
+	})
+	echo := proto.Clone(relay).(*videowithyoupb.Reaction)
+	echo.RequestId = msg.RequestId
+	_ = s.sendEnvelope(client, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_Reaction{Reaction: echo},
+	})
+}
+
+// storeReactionLocked files reaction under key, evicting the oldest
+// reaction or media once the room is at its limits. It is called with s.mu
+// held.
+func storeReactionLocked(room *Room, key string, reaction *videowithyoupb.Reaction) {
+	if room.reactions == nil {
+		room.reactions = make(map[string][]*videowithyoupb.Reaction)
+	}
+	list, ok := room.reactions[key]
+	if !ok && len(room.reactions) >= reactionMediaLimit {
+		// Make room by forgetting the media reacted to least recently.
+		stalest, stalestAt := "", int64(0)
+		for other, reactions := range room.reactions {
+			last := reactions[len(reactions)-1].ServerTimeMs
+			if stalest == "" || last < stalestAt {
+				stalest, stalestAt = other, last
+			}
+		}
+		delete(room.reactions, stalest)
+	}
+	if len(list) >= reactionsPerMedia {
+		list = append(list[:0], list[1:]...)
+	}
+	room.reactions[key] = append(list, reaction)
+}
+
+// reactionBatchLocked returns the reactions stored for key, ordered by
+// position. It is called with s.mu held.
+func reactionBatchLocked(room *Room, key string) *videowithyoupb.ReactionBatch {
+	stored := room.reactions[key]
+	reactions := make([]*videowithyoupb.Reaction, len(stored))
+	copy(reactions, stored)
+	sort.SliceStable(reactions, func(i, j int) bool { return reactions[i].PositionMs < reactions[j].PositionMs })
+	return &videowithyoupb.ReactionBatch{RoomId: room.id, MediaUrl: key, Reactions: reactions}
+}
+
+// sendReactionBatch replays the reactions for the room's current media to
+// targets.
+func (s *Server) sendReactionBatch(room *Room, targets []*Client) {
+	s.mu.RLock()
+	key := mediaKey(currentStateLocked(room, time.Now()).GetMedia())
+	if key == "" {
+		s.mu.RUnlock()
+		return
+	}
+	batch := reactionBatchLocked(room, key)
+	s.mu.RUnlock()
+
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ReactionBatch{ReactionBatch: batch},
+	})
+}
+
+// reactionExportLocked returns room's reactions in export form. It is called
+// with s.mu held.
+func reactionExportLocked(room *Room, reason string, now time.Time) reactionExport {
+	export := reactionExport{
+		RoomID:     room.id,
+		RoomCode:   room.code,
+		ExportedAt: now.UnixMilli(),
+		Reason:     reason,
+		Media:      make([]reactionExportMedia, 0, len(room.reactions)),
+	}
+	for key := range room.reactions {
+		batch := reactionBatchLocked(room, key)
+		media := reactionExportMedia{
+			MediaURL:  key,
+			Reactions: make([]reactionExportRecord, 0, len(batch.Reactions)),
+		}
+		for _, reaction := range batch.Reactions {
+			media.MediaTitle = reaction.MediaTitle
+			media.Reactions = append(media.Reactions, reactionExportRecord{
+				ReactionID:   reaction.ReactionId,
+				MemberID:     reaction.MemberId,
+				DisplayName:  reaction.DisplayName,
+				PositionMs:   reaction.PositionMs,
+				Emoji:        reaction.Emoji,
+				Text:         reaction.Text,
+				ServerTimeMs: reaction.ServerTimeMs,
+			})
+		}
+		export.Media = append(export.Media, media)
+	}
+	sort.Slice(export.Media, func(i, j int) bool { return export.Media[i].MediaURL < export.Media[j].MediaURL })
+	return export
+}
+
+// exportReactionsLocked writes room's reactions to the export directory, if
+// one is set and the room has any. The file is written in the background;
+// it is called with s.mu held.
+func (s *Server) exportReactionsLocked(room *Room, reason string) {
+	if s.reactionExportDir == "" || len(room.reactions) == 0 {
+		return
+	}
+	export := reactionExportLocked(room, reason, time.Now())
+	path := filepath.Join(s.reactionExportDir, room.id+".json")
+	go func() {
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err == nil {
+			tmp := path + ".tmp"
+			if err = os.WriteFile(tmp, data, 0o644); err == nil {
+				err = os.Rename(tmp, path)
+			}
+		}
+		if err != nil {
+			s.log.Printf("reaction export %s failed: %v", room.id, err)
+			return
+		}
+		s.log.Printf("reaction export %s media=%d path=%s", room.id, len(export.Media), path)
+	}()
+}
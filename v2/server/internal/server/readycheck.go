@@ -0,0 +1,171 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	readyCheckTimeoutDefault = 30 * time.Second
+	readyCheckTimeoutMin     = 5 * time.Second
+	readyCheckTimeoutMax     = 2 * time.Minute
+	// readyStartLead is how far ahead the start is scheduled once a check
+	// ends, leaving members time to seek to the start position.
+	readyStartLead = 2 * time.Second
+)
+
+// readyCheck tracks which members acknowledged the room's current check.
+type readyCheck struct {
+	id       string
+	ready    map[string]bool
+	deadline time.Time
+	timer    *time.Timer
+}
+
+func (s *Server) handleReadyCheckReq(client *Client, req *videowithyoupb.ReadyCheckReq) {
+	if req == nil {
+		return
+	}
+	timeout := time.Duration(req.TimeoutMs) * time.Millisecond
+	if timeout <= 0 {
+		timeout = readyCheckTimeoutDefault
+	} else if timeout < readyCheckTimeoutMin {
+		timeout = readyCheckTimeoutMin
+	} else if timeout > readyCheckTimeoutMax {
+		timeout = readyCheckTimeoutMax
+	}
+
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, req.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	if previous := room.readyCheck; previous != nil {
+		previous.timer.Stop()
+	}
+	check := &readyCheck{
+		id:       randomID(),
+		ready:    map[string]bool{client.id: true},
+		deadline: time.Now().Add(timeout),
+	}
+	check.timer = time.AfterFunc(timeout, func() { s.finishReadyCheck(room, check.id, true) })
+	room.readyCheck = check
+	s.mu.Unlock()
+
+	s.log.Printf("ready check %s room=%s timeout=%s", check.id, room.id, timeout)
+	s.updateReadyCheck(room)
+}
+
+func (s *Server) handleReadyCheckAck(client *Client, ack *videowithyoupb.ReadyCheckAck) {
+	if ack == nil {
+		return
+	}
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != ack.RoomId || room.readyCheck == nil || room.readyCheck.id != ack.CheckId {
+		s.mu.Unlock()
+		return
+	}
+	room.readyCheck.ready[client.id] = ack.Ready
+	s.mu.Unlock()
+
+	s.updateReadyCheck(room)
+}
+
+// updateReadyCheck sends the room's check progress to every member, or ends
+// the check once every connected member is ready.
+func (s *Server) updateReadyCheck(room *Room) {
+	s.mu.Lock()
+	check := room.readyCheck
+	if s.rooms[room.id] != room || check == nil {
+		s.mu.Unlock()
+		return
+	}
+	progress := readyCheckProgressLocked(room, time.Now())
+	targets := connectedMembersLocked(room)
+	s.mu.Unlock()
+
+	if len(progress.WaitingMemberIds) == 0 {
+		s.finishReadyCheck(room, check.id, false)
+		return
+	}
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ReadyCheck{ReadyCheck: progress},
+	})
+}
+
+// finishReadyCheck ends check id and schedules the shared start. It does
+// nothing if that check already ended or was replaced.
+func (s *Server) finishReadyCheck(room *Room, id string, timedOut bool) {
+	now := time.Now()
+
+	s.mu.Lock()
+	check := room.readyCheck
+	if s.rooms[room.id] != room || check == nil || check.id != id {
+		s.mu.Unlock()
+		return
+	}
+	check.timer.Stop()
+	result := readyCheckProgressLocked(room, now)
+	room.readyCheck = nil
+	result.Done = true
+	result.TimedOut = timedOut
+	result.Rate = 1
+	if state := currentStateLocked(room, now); state != nil {
+		result.PositionMs = extrapolatePosition(state, now)
+		result.Rate = state.Rate
+	}
+	result.StartAtServerTimeMs = now.Add(readyStartLead).UnixMilli()
+	targets := connectedMembersLocked(room)
+	s.mu.Unlock()
+
+	s.log.Printf("ready check %s done room=%s timed_out=%t position=%d start_at=%d", id, room.id, timedOut, result.PositionMs, result.StartAtServerTimeMs)
+	s.sendToAll(targets, &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ReadyCheck{ReadyCheck: result},
+	})
+}
+
+// readyCheckProgressLocked describes the room's current check. Members that
+// are disconnected are not waited for. It is called with s.mu held.
+func readyCheckProgressLocked(room *Room, now time.Time) *videowithyoupb.ReadyCheck {
+	check := room.readyCheck
+	progress := &videowithyoupb.ReadyCheck{
+		RoomId:               room.id,
+		CheckId:              check.id,
+		DeadlineServerTimeMs: check.deadline.UnixMilli(),
+		ServerTimeMs:         now.UnixMilli(),
+	}
+	for id, member := range room.members {
+		switch {
+		case check.ready[id]:
+			progress.ReadyMemberIds = append(progress.ReadyMemberIds, id)
+		case member.connected:
+			progress.WaitingMemberIds = append(progress.WaitingMemberIds, id)
+		}
+	}
+	sort.Strings(progress.ReadyMemberIds)
+	sort.Strings(progress.WaitingMemberIds)
+	return progress
+}
+
+// connectedMembersLocked returns the room's connected members. It is called
+// with s.mu held.
+func connectedMembersLocked(room *Room) []*Client {
+	targets := make([]*Client, 0, len(room.members))
+	for _, member := range room.members {
+		if member.connected {
+			targets = append(targets, member)
+		}
+	}
+	return targets
+}
+
+func (s *Server) sendToAll(targets []*Client, env *videowithyoupb.Envelope) {
+	for _, member := range targets {
+		_ = s.sendEnvelope(member, env)
+	}
+}
@@ -0,0 +1,399 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	redisDialTimeout = 5 * time.Second
+	redisIOTimeout   = 5 * time.Second
+	// redisRetryDelay is how long the subscriber waits before reconnecting
+	// after losing its connection.
+	redisRetryDelay = time.Second
+	redisCodePrefix = "vwy:room-code:"
+)
+
+// Room code scripts run on the Redis server so checking the owner and
+// changing the key are one step. Both take the key, the instance and (for
+// refresh) the ttl in milliseconds, 0 meaning none.
+const (
+	redisRefreshScript = `
+local owner = redis.call('GET', KEYS[1])
+if owner and owner ~= ARGV[1] then
+	return 0
+end
+if ARGV[2] == '0' then
+	redis.call('SET', KEYS[1], ARGV[1])
+else
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+end
+return 1`
+	redisReleaseScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0`
+)
+
+// RedisBroker is a Broker backed by a server speaking the Redis protocol:
+// channels map to PUBLISH/SUBSCRIBE and room codes to keys. Commands share
+// one connection; subscriptions hold a second one, since a subscribed
+// connection cannot run other commands.
+type RedisBroker struct {
+	addr string
+	log  *log.Logger
+
+	// mu guards cmd, which is redialled after an error.
+	mu  sync.Mutex
+	cmd *respConn
+
+	subMu    sync.Mutex
+	sub      *respConn
+	handlers map[string]func([]byte)
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRedisBroker connects to the Redis server at addr (host:port).
+func NewRedisBroker(addr string, logger *log.Logger) (*RedisBroker, error) {
+	if logger == nil {
+		logger = log.Default()
+	}
+	cmd, err := dialResp(addr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cmd.do("PING"); err != nil {
+		cmd.close()
+		return nil, err
+	}
+	sub, err := dialResp(addr)
+	if err != nil {
+		cmd.close()
+		return nil, err
+	}
+	b := &RedisBroker{
+		addr:     addr,
+		log:      logger,
+		cmd:      cmd,
+		sub:      sub,
+		handlers: make(map[string]func([]byte)),
+		closed:   make(chan struct{}),
+	}
+	go b.receiveLoop(sub)
+	return b, nil
+}
+
+func (b *RedisBroker) Publish(channel string, payload []byte) error {
+	_, err := b.do("PUBLISH", channel, string(payload))
+	return err
+}
+
+func (b *RedisBroker) Subscribe(channel string, handler func(payload []byte)) error {
+	b.subMu.Lock()
+	defer b.subMu.Unlock()
+	if b.isClosed() {
+		return errBrokerClosed
+	}
+	b.handlers[channel] = handler
+	if b.sub == nil {
+		// receiveLoop is reconnecting and resubscribes to every channel.
+		return nil
+	}
+	return b.sub.send("SUBSCRIBE", channel)
+}
+
+func (b *RedisBroker) ClaimRoomCode(code, instance string, ttl time.Duration) (bool, error) {
+	args := []string{"SET", redisCodePrefix + code, instance, "NX"}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	reply, err := b.do(args...)
+	if err != nil {
+		return false, err
+	}
+	if reply != nil {
+		return true, nil
+	}
+	// Taken; it may already be ours.
+	owner, err := b.RoomCodeOwner(code)
+	return owner == instance, err
+}
+
+func (b *RedisBroker) RefreshRoomCode(code, instance string, ttl time.Duration) (bool, error) {
+	ttlMs := int64(0)
+	if ttl > 0 {
+		ttlMs = ttl.Milliseconds()
+	}
+	reply, err := b.do("EVAL", redisRefreshScript, "1", redisCodePrefix+code, instance, strconv.FormatInt(ttlMs, 10))
+	if err != nil {
+		return false, err
+	}
+	owned, ok := reply.(int64)
+	if !ok {
+		return false, fmt.Errorf("redis: unexpected EVAL reply %T", reply)
+	}
+	return owned == 1, nil
+}
+
+func (b *RedisBroker) RoomCodeOwner(code string) (string, error) {
+	reply, err := b.do("GET", redisCodePrefix+code)
+	if err != nil || reply == nil {
+		return "", err
+	}
+	owner, ok := reply.([]byte)
+	if !ok {
+		return "", fmt.Errorf("redis: unexpected GET reply %T", reply)
+	}
+	return string(owner), nil
+}
+
+func (b *RedisBroker) ReleaseRoomCode(code, instance string) error {
+	_, err := b.do("EVAL", redisReleaseScript, "1", redisCodePrefix+code, instance)
+	return err
+}
+
+func (b *RedisBroker) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+		b.mu.Lock()
+		if b.cmd != nil {
+			b.cmd.close()
+			b.cmd = nil
+		}
+		b.mu.Unlock()
+		b.subMu.Lock()
+		if b.sub != nil {
+			b.sub.close()
+			b.sub = nil
+		}
+		b.subMu.Unlock()
+	})
+	return nil
+}
+
+func (b *RedisBroker) isClosed() bool {
+	select {
+	case <-b.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// do runs a command on the command connection, redialling once if the
+// connection was lost.
+func (b *RedisBroker) do(args ...string) (any, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.isClosed() {
+		return nil, errBrokerClosed
+	}
+	for attempt := 0; ; attempt++ {
+		if b.cmd == nil {
+			cmd, err := dialResp(b.addr)
+			if err != nil {
+				return nil, err
+			}
+			b.cmd = cmd
+		}
+		reply, err := b.cmd.do(args...)
+		var replyErr redisError
+		if err == nil || errors.As(err, &replyErr) {
+			return reply, err
+		}
+		b.cmd.close()
+		b.cmd = nil
+		if attempt > 0 {
+			return nil, err
+		}
+	}
+}
+
+// receiveLoop reads pushed messages from conn and hands them to the
+// channel's handler, reconnecting and resubscribing when conn fails.
+func (b *RedisBroker) receiveLoop(conn *respConn) {
+	for {
+		err := b.receive(conn)
+		if b.isClosed() {
+			return
+		}
+		b.log.Printf("redis broker %s: subscriber connection lost: %v", b.addr, err)
+		b.subMu.Lock()
+		if b.sub == conn {
+			b.sub = nil
+		}
+		b.subMu.Unlock()
+		conn.close()
+
+		for conn = nil; conn == nil; {
+			select {
+			case <-b.closed:
+				return
+			case <-time.After(redisRetryDelay):
+			}
+			conn = b.resubscribe()
+		}
+		b.log.Printf("redis broker %s: subscriber reconnected", b.addr)
+	}
+}
+
+func (b *RedisBroker) resubscribe() *respConn {
+	conn, err := dialResp(b.addr)
+	if err != nil {
+		return nil
+	}
+	b.subMu.Lock()
+	defer b.subMu.Unlock()
+	if b.isClosed() {
+		conn.close()
+		return nil
+	}
+	for channel := range b.handlers {
+		if err := conn.send("SUBSCRIBE", channel); err != nil {
+			conn.close()
+			return nil
+		}
+	}
+	b.sub = conn
+	return conn
+}
+
+func (b *RedisBroker) receive(conn *respConn) error {
+	for {
+		reply, err := conn.read()
+		if err != nil {
+			return err
+		}
+		parts, ok := reply.([]any)
+		if !ok || len(parts) != 3 {
+			continue
+		}
+		kind, _ := parts[0].([]byte)
+		channel, _ := parts[1].([]byte)
+		payload, _ := parts[2].([]byte)
+		if string(kind) != "message" {
+			continue
+		}
+		b.subMu.Lock()
+		handler := b.handlers[string(channel)]
+		b.subMu.Unlock()
+		if handler != nil {
+			handler(payload)
+		}
+	}
+}
+
+// redisError is an error reply from the server, as opposed to a failed
+// connection.
+type redisError string
+
+func (e redisError) Error() string { return "redis: " + string(e) }
+
+// respConn speaks RESP, the Redis wire protocol. Replies decode to []byte
+// (simple and bulk strings), int64, []any, nil or redisError.
+type respConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	// wmu lets Subscribe write while receiveLoop reads.
+	wmu sync.Mutex
+	w   *bufio.Writer
+}
+
+func dialResp(addr string) (*respConn, error) {
+	conn, err := net.DialTimeout("tcp", addr, redisDialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &respConn{conn: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}, nil
+}
+
+func (c *respConn) close() {
+	_ = c.conn.Close()
+}
+
+// do sends a command and reads its reply. An error reply is returned as a
+// redisError.
+func (c *respConn) do(args ...string) (any, error) {
+	if err := c.send(args...); err != nil {
+		return nil, err
+	}
+	_ = c.conn.SetReadDeadline(time.Now().Add(redisIOTimeout))
+	reply, err := c.read()
+	_ = c.conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(redisError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+func (c *respConn) send(args ...string) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(redisIOTimeout))
+	fmt.Fprintf(c.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	return c.w.Flush()
+}
+
+func (c *respConn) read() (any, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return []byte(body), nil
+	case '-':
+		return redisError(body), nil
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, data); err != nil {
+			return nil, err
+		}
+		return data[:n], nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]any, n)
+		for i := range items {
+			if items[i], err = c.read(); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("redis: unknown reply type %q", kind)
+}
@@ -2,12 +2,16 @@ package server
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -17,46 +21,179 @@ import (
 )
 
 const (
-	writeWait  = 10 * time.Second
-	pongWait   = 30 * time.Second
-	pingPeriod = 15 * time.Second
-	hostIdleTimeoutDefault  = 600 * time.Second
-	hostIdleCheckInterval   = 5 * time.Second
+	writeWait              = 10 * time.Second
+	pongWait               = 30 * time.Second
+	pingPeriod             = 15 * time.Second
+	hostIdleTimeoutDefault = 600 * time.Second
+	hostIdleCheckInterval  = 5 * time.Second
+	resumeGraceDefault     = 30 * time.Second
+	sessionCheckInterval   = time.Second
+	statePersistInterval   = 5 * time.Second
+	maxMessageBytesDefault = 2 << 20
 )
 
 var roomAlphabet = []byte("ABCDEFGHJKLMNPQRSTUVWXYZ23456789")
 
-type Config struct {
-	Addr string
-	Path string
-}
-
 type Server struct {
-	log       *log.Logger
-	mu        sync.RWMutex
-	rooms     map[string]*Room
-	roomCodes map[string]string
-	upgrader  websocket.Upgrader
+	log             *log.Logger
+	mu              sync.RWMutex
+	rooms           map[string]*Room
+	roomCodes       map[string]string
+	upgrader        websocket.Upgrader
 	hostIdleTimeout time.Duration
+	resumeGrace     time.Duration
+	// sessions maps resume tokens to the client currently holding them,
+	// including disconnected clients whose room slot is still held.
+	sessions map[string]*Client
+	store    RoomStore
+	// storeQueue hands room writes to the goroutine that applies them to
+	// store, so nothing holding mu waits on disk.
+	storeQueue *storeQueue
+
+	metrics metrics
+	ready   atomic.Bool
+
+	maxConnections  int
+	maxMessageBytes int64
+	// shuttingDown rejects new connections and keeps closing sockets from
+	// touching rooms.
+	shuttingDown atomic.Bool
+
+	sendQueueLimit      int
+	slowConsumerTimeout time.Duration
+
+	// reactionExportDir receives each room's reactions when it closes;
+	// empty disables the export.
+	reactionExportDir string
+
+	// broker connects the instances sharing rooms; by default it is private
+	// to this server. codeTTL is how long room code claims last without a
+	// refresh, zero for as long as the room.
+	broker     Broker
+	instanceID string
+	codeTTL    time.Duration
+	// local holds connected clients by id so relayed messages can reach
+	// them; stubs holds members connected through other instances.
+	local        map[string]*Client
+	stubs        map[string]*Client
+	instanceSeen map[string]time.Time
+	// instanceRooms holds the directory entries each other instance
+	// announced with its last heartbeat.
+	instanceRooms map[string][]*videowithyoupb.PublicRoom
+
+	// minProtocolVersion is the oldest client protocol accepted.
+	minProtocolVersion uint32
+
+	limiter *rateLimiter
 }
 
 type Room struct {
-	id          string
-	code        string
-	hostID      string
-	members     map[string]*Client
-	latestState *videowithyoupb.HostState
+	id              string
+	code            string
+	hostID          string
+	members         map[string]*Client
+	latestState     *videowithyoupb.HostState
 	lastHostStateAt time.Time
+
+	joinPolicy   videowithyoupb.JoinPolicy
+	passwordSalt []byte
+	passwordHash []byte
+	// pending holds joiners waiting for the host in approval rooms.
+	pending map[string]*pendingJoin
+	// banned and muted are keyed by client identity so they survive a
+	// reconnect under a new client id (muted falls back to the member id,
+	// see muteKey). The identity is the client's to
+	// choose, so bannedIPs also holds the addresses banned members came
+	// from.
+	banned    map[string]struct{}
+	bannedIPs map[string]struct{}
+	muted     map[string]struct{}
+
+	persistedAt time.Time
+	// lastSeq is the seq of the last accepted HostState from the current
+	// host; it restarts when the host changes.
+	lastSeq        uint64
+	skewNotifiedAt time.Time
+
+	// reports holds followers' latest FollowerReport; reportsDirty marks
+	// that the host has not seen the current set.
+	reports      map[string]*followerReport
+	reportsDirty bool
+
+	// pauseOnBuffering holds the room while an active member buffers; hold
+	// is the position it is held at.
+	pauseOnBuffering bool
+	hold             *bufferHold
+	controlMode      videowithyoupb.ControlMode
+	// readyCheck is the check in progress, if any.
+	readyCheck *readyCheck
+	// premiere is the room's scheduled start, if it was created with one.
+	premiere *premiere
+	chat     chatLog
+	// reactions holds the room's reactions by media key, in arrival order.
+	reactions map[string][]*videowithyoupb.Reaction
+	// playlist is the room's shared queue; membersEditPlaylist lets
+	// members other than the host change it.
+	playlist            playlist
+	membersEditPlaylist bool
+	// public lists the room in the directory under title and description.
+	public      bool
+	title       string
+	description string
+}
+
+type pendingJoin struct {
+	client      *Client
+	requestID   string
+	requestedAt time.Time
 }
 
 type Client struct {
-	id     string
-	name   string
-	conn   *websocket.Conn
-	send   chan []byte
-	roomID string
-	isHost bool
-	active bool
+	id       string
+	name     string
+	identity string
+	conn     *websocket.Conn
+	out      *outbox
+	// json is set for clients that speak protojson text frames.
+	json     bool
+	roomID   string
+	isHost   bool
+	active   bool
+	joinedAt time.Time
+	// buffering is the member's last reported player stall.
+	buffering bool
+	// reactionSentAt holds when the member's recent reactions were sent.
+	reactionSentAt []time.Time
+	// pendingRoomID is the approval room this client is waiting to enter.
+	pendingRoomID string
+
+	resumeToken string
+	// connected is false while the client's room slot is held for resume;
+	// expiresAt is when that hold ends.
+	connected bool
+	expiresAt time.Time
+	// replaced is set when a resumed connection took over this client.
+	replaced bool
+	// writerDone is closed when writeLoop exits.
+	writerDone chan struct{}
+	// sse is set for clients on the HTTP fallback instead of a websocket.
+	sse *sseStream
+
+	// remote is set on a stub: the instance holding the member's socket.
+	// home is the instance hosting the room this local client is in, if it
+	// is not this one.
+	remote string
+	home   string
+
+	// protocolVersion and caps are what the client negotiated in its hello;
+	// messages for other capabilities are neither sent nor accepted.
+	protocolVersion uint32
+	caps            capabilitySet
+
+	// ip is the address the connection came from, empty on stubs; limits
+	// holds the connection's rate limit buckets by kind.
+	ip     string
+	limits map[string]*tokenBucket
 }
 
 func NewServer(logger *log.Logger) *Server {
@@ -68,11 +205,33 @@ func NewServer(logger *log.Logger) *Server {
 		rooms:     make(map[string]*Room),
 		roomCodes: make(map[string]string),
 		upgrader: websocket.Upgrader{
-			CheckOrigin: func(r *http.Request) bool { return true },
+			CheckOrigin:  func(r *http.Request) bool { return true },
+			Subprotocols: []string{subprotocolProto, subprotocolJSON},
 		},
 		hostIdleTimeout: hostIdleTimeoutDefault,
+		resumeGrace:     resumeGraceDefault,
+		sessions:        make(map[string]*Client),
+		store:           NewMemoryStore(),
+		storeQueue:      newStoreQueue(),
+		maxMessageBytes: maxMessageBytesDefault,
+
+		sendQueueLimit:      sendQueueLimitDefault,
+		slowConsumerTimeout: slowConsumerTimeoutDefault,
+
+		broker:        NewMemoryBroker(logger),
+		instanceID:    randomID(),
+		local:         make(map[string]*Client),
+		stubs:         make(map[string]*Client),
+		instanceSeen:  make(map[string]time.Time),
+		instanceRooms: make(map[string][]*videowithyoupb.PublicRoom),
+
+		minProtocolVersion: protocolVersionLegacy,
+		limiter:            newRateLimiter(),
 	}
 	go srv.hostIdleLoop()
+	go srv.sessionExpiryLoop()
+	go srv.syncHealthLoop()
+	go srv.storeLoop()
 	return srv
 }
 
@@ -83,7 +242,62 @@ func (s *Server) SetHostIdleTimeout(timeout time.Duration) {
 	s.hostIdleTimeout = timeout
 }
 
+// SetResumeGrace sets how long a disconnected member's room slot is held for
+// a resume. Zero removes members as soon as they disconnect.
+func (s *Server) SetResumeGrace(grace time.Duration) {
+	if grace < 0 {
+		return
+	}
+	s.resumeGrace = grace
+}
+
+// SetMaxConnections caps open websocket connections. Zero means no limit.
+func (s *Server) SetMaxConnections(max int) {
+	if max < 0 {
+		return
+	}
+	s.maxConnections = max
+}
+
+// reserveConnection counts a new connection unless the cap is reached. The
+// check and the increment are one step so concurrent upgrades cannot overshoot
+// the cap; the caller decrements connections when the connection ends.
+func (s *Server) reserveConnection() bool {
+	for {
+		open := s.metrics.connections.Load()
+		if s.maxConnections > 0 && open >= int64(s.maxConnections) {
+			return false
+		}
+		if s.metrics.connections.CompareAndSwap(open, open+1) {
+			return true
+		}
+	}
+}
+
+func (s *Server) SetMaxMessageBytes(max int64) {
+	if max <= 0 {
+		return
+	}
+	s.maxMessageBytes = max
+}
+
+// HandleWS serves clients over websocket, in binary protobuf unless they ask
+// for the JSON subprotocol.
 func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
+	s.serveWS(w, r, false)
+}
+
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request, json bool) {
+	if s.shuttingDown.Load() {
+		http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	if !s.reserveConnection() {
+		http.Error(w, "too many connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.metrics.connections.Add(-1)
+
 	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		s.log.Printf("ws upgrade failed: %v", err)
@@ -91,62 +305,229 @@ func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
 	}
 
 	client := &Client{
-		id:   randomID(),
-		conn: conn,
-		send: make(chan []byte, 64),
-		active: true,
+		id:         randomID(),
+		conn:       conn,
+		out:        newOutbox(),
+		json:       json || conn.Subprotocol() == subprotocolJSON,
+		active:     true,
+		connected:  true,
+		writerDone: make(chan struct{}),
+		ip:         s.clientIP(r),
 	}
 
-	conn.SetReadLimit(2 << 20)
+	conn.SetReadLimit(s.maxMessageBytes)
 	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
 	conn.SetPongHandler(func(string) error {
 		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
 		return nil
 	})
 
-	if err := s.handleHello(client); err != nil {
+	if err := s.readHello(client); err != nil {
 		s.log.Printf("hello failed: %v", err)
 		_ = conn.Close()
 		return
 	}
 
+	s.registerLocal(client)
 	done := make(chan struct{})
 	go s.writeLoop(client, done)
 	s.readLoop(client)
 
 	close(done)
+	s.forgetLocal(client)
 	s.cleanupClient(client)
 	_ = conn.Close()
 }
 
-func (s *Server) handleHello(client *Client) error {
+func (s *Server) readHello(client *Client) error {
 	msgType, data, err := client.conn.ReadMessage()
 	if err != nil {
 		return err
 	}
-	if msgType != websocket.BinaryMessage {
-		return errors.New("expected binary hello")
-	}
-
-	env := &videowithyoupb.Envelope{}
-	if err := proto.Unmarshal(data, env); err != nil {
+	env, err := decodeEnvelope(client, msgType, data)
+	if err != nil {
 		return err
 	}
+	return s.handleHello(client, env)
+}
+
+// handleHello answers env, the first message on a new connection, which must
+// be a ClientHello.
+func (s *Server) handleHello(client *Client, env *videowithyoupb.Envelope) error {
 	hello := env.GetClientHello()
 	if hello == nil {
 		return errors.New("missing client_hello")
 	}
 
+	client.protocolVersion, client.caps = negotiate(hello)
+	if client.protocolVersion < s.minProtocolVersion {
+		s.rejectHello(client, fmt.Sprintf("upgrade required: protocol %d is older than the minimum %d", client.protocolVersion, s.minProtocolVersion))
+		return fmt.Errorf("client %q speaks protocol %d, minimum is %d", hello.GetClientVersion(), client.protocolVersion, s.minProtocolVersion)
+	}
+
 	client.name = hello.GetClientName()
+	identity := strings.TrimSpace(hello.GetClientIdentity())
+	var room *Room
+	resumed := false
+	if client.caps.has(capResume) {
+		room, resumed = s.resumeSession(client, hello.GetResumeToken())
+	}
+	if !resumed {
+		s.registerSession(client)
+	}
+	client.identity = identity
 	resp := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_ServerHello{
 			ServerHello: &videowithyoupb.ServerHello{
-				ClientId:     client.id,
+				ClientId:        client.id,
+				ServerTimeMs:    time.Now().UnixMilli(),
+				ResumeToken:     client.resumeToken,
+				Resumed:         resumed,
+				ProtocolVersion: min(client.protocolVersion, protocolVersion),
+				Capabilities:    client.caps.list(),
+			},
+		},
+	}
+	if err := s.sendEnvelope(client, resp); err != nil {
+		return err
+	}
+	if !resumed {
+		return nil
+	}
+	if room == nil {
+		// The slot is held by the instance hosting the room, which
+		// reattaches it when the hello reaches it.
+		s.log.Printf("client resumed %s via instance %s", client.id, client.home)
+		s.resumeAtHome(client)
+		return nil
+	}
+
+	s.log.Printf("client resumed %s room=%s host=%t", client.id, room.id, client.isHost)
+	s.sendResumedRoom(client, room)
+	return nil
+}
+
+// sendResumedRoom brings client, which just reattached its slot in room, up
+// to date with the room.
+func (s *Server) sendResumedRoom(client *Client, room *Room) {
+	s.broadcastRoomSnapshot(room)
+	if client.isHost {
+		s.notifyPendingJoins(room)
+	}
+	s.mu.RLock()
+	latest := currentStateLocked(room, time.Now())
+	s.mu.RUnlock()
+	if latest != nil && !client.isHost {
+		s.broadcastHostState(room, latest)
+	}
+	s.sendReactionBatch(room, []*Client{client})
+}
+
+// rejectHello writes an UPGRADE_REQUIRED error and a close straight to
+// client's connection; its writer has not started yet.
+func (s *Server) rejectHello(client *Client, message string) {
+	payload, err := proto.Marshal(&videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ErrorResp{
+			ErrorResp: &videowithyoupb.ErrorResp{
+				Message:      message,
 				ServerTimeMs: time.Now().UnixMilli(),
+				Code:         videowithyoupb.ErrorCode_ERROR_CODE_UPGRADE_REQUIRED,
 			},
 		},
+	})
+	if err != nil {
+		return
+	}
+	if client.sse != nil {
+		_ = client.sse.send(client, payload)
+		_ = client.sse.event("close", []byte("upgrade required"))
+		return
+	}
+	msgType, data, err := encodeFrame(client, payload)
+	if err != nil {
+		return
+	}
+	_ = client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	_ = client.conn.WriteMessage(msgType, data)
+	_ = client.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "upgrade required"))
+}
+
+func (s *Server) registerSession(client *Client) {
+	client.resumeToken = randomID()
+	s.mu.Lock()
+	s.sessions[client.resumeToken] = client
+	s.mu.Unlock()
+}
+
+// resumeSession moves the room slot held under token onto client, keeping the
+// old client id, room and role, and reports whether anything was held. The
+// room is nil when it lives on another instance.
+func (s *Server) resumeSession(client *Client, token string) (*Room, bool) {
+	if token == "" {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	old := s.sessions[token]
+	if old == nil {
+		s.mu.Unlock()
+		return nil, false
+	}
+	return s.takeOverLocked(client, old, token)
+}
+
+// takeOverLocked moves old's room slot onto client. It must be called with
+// s.mu held and releases it.
+func (s *Server) takeOverLocked(client, old *Client, token string) (*Room, bool) {
+	// A member of a room on another instance keeps its session here and
+	// its slot there.
+	remote := old.home != ""
+	room := s.rooms[old.roomID]
+	if !remote && (room == nil || room.members[old.id] != old) {
+		s.mu.Unlock()
+		return nil, false
+	}
+	client.id = old.id
+	client.roomID = old.roomID
+	client.isHost = old.isHost
+	client.active = true
+	client.joinedAt = old.joinedAt
+	client.resumeToken = token
+	client.home = old.home
+	s.sessions[token] = client
+	if remote {
+		// The home may answer the resumed hello before the connection
+		// registers itself.
+		s.local[client.id] = client
+	} else {
+		room.members[client.id] = client
+	}
+
+	// The old connection may not have timed out yet; retire it without
+	// letting its cleanup touch the room.
+	var stale *Client
+	if old.connected {
+		old.replaced = true
+		stale = old
 	}
-	return s.sendEnvelope(client, resp)
+	old.roomID = ""
+	old.isHost = false
+	old.home = ""
+	s.mu.Unlock()
+
+	if stale != nil {
+		stale.closeConnection()
+	}
+	return room, true
+}
+
+// closeConnection drops client's websocket or ends its event stream.
+func (c *Client) closeConnection() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+		return
+	}
+	c.out.abort(nil)
 }
 
 func (s *Server) handleClientHelloUpdate(client *Client, hello *videowithyoupb.ClientHello) {
@@ -175,51 +556,108 @@ func (s *Server) readLoop(client *Client) {
 			s.log.Printf("client %s read error: %v", client.id, err)
 			return
 		}
-		if msgType != websocket.BinaryMessage {
+		env, err := decodeEnvelope(client, msgType, data)
+		if errors.Is(err, errWrongFrameType) {
 			continue
 		}
-
-		env := &videowithyoupb.Envelope{}
-		if err := proto.Unmarshal(data, env); err != nil {
+		if err != nil {
 			s.log.Printf("client %s bad envelope: %v", client.id, err)
 			continue
 		}
-
-		switch payload := env.Payload.(type) {
-		case *videowithyoupb.Envelope_ClientHello:
-			s.handleClientHelloUpdate(client, payload.ClientHello)
-		case *videowithyoupb.Envelope_CreateRoomReq:
-			s.handleCreateRoom(client, payload.CreateRoomReq)
-		case *videowithyoupb.Envelope_JoinRoomReq:
-			s.handleJoinRoom(client, payload.JoinRoomReq)
-		case *videowithyoupb.Envelope_LeaveRoomReq:
-			s.handleLeaveRoom(client, payload.LeaveRoomReq)
-		case *videowithyoupb.Envelope_MemberStatus:
-			s.handleMemberStatus(client, payload.MemberStatus)
-		case *videowithyoupb.Envelope_HostState:
-			s.handleHostState(client, payload.HostState)
-		case *videowithyoupb.Envelope_TimeSyncReq:
-			s.handleTimeSync(client, payload.TimeSyncReq)
-		default:
-			s.log.Printf("client %s unknown payload", client.id)
+		if !s.allowRequest(client, env) {
+			continue
+		}
+		if !s.relayToHome(client, env, data) {
+			s.dispatch(client, env)
 		}
 	}
 }
 
+// dispatch handles an envelope from client, which may be a stub for a
+// member connected to another instance.
+func (s *Server) dispatch(client *Client, env *videowithyoupb.Envelope) {
+	if capability := envelopeCapability(env); capability != "" && !client.caps.has(capability) {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, "", "capability not negotiated: "+capability)
+		return
+	}
+	switch payload := env.Payload.(type) {
+	case *videowithyoupb.Envelope_ClientHello:
+		s.handleClientHelloUpdate(client, payload.ClientHello)
+	case *videowithyoupb.Envelope_CreateRoomReq:
+		s.handleCreateRoom(client, payload.CreateRoomReq)
+	case *videowithyoupb.Envelope_JoinRoomReq:
+		s.handleJoinRoom(client, payload.JoinRoomReq)
+	case *videowithyoupb.Envelope_ListRoomsReq:
+		s.handleListRooms(client, payload.ListRoomsReq)
+	case *videowithyoupb.Envelope_LeaveRoomReq:
+		s.handleLeaveRoom(client, payload.LeaveRoomReq)
+	case *videowithyoupb.Envelope_TransferHostReq:
+		s.handleTransferHost(client, payload.TransferHostReq)
+	case *videowithyoupb.Envelope_JoinDecision:
+		s.handleJoinDecision(client, payload.JoinDecision)
+	case *videowithyoupb.Envelope_KickMemberReq:
+		s.handleModerateMember(client, payload.KickMemberReq.GetRoomId(), payload.KickMemberReq.GetMemberId(), payload.KickMemberReq.GetRequestId(), false)
+	case *videowithyoupb.Envelope_BanMemberReq:
+		s.handleModerateMember(client, payload.BanMemberReq.GetRoomId(), payload.BanMemberReq.GetMemberId(), payload.BanMemberReq.GetRequestId(), true)
+	case *videowithyoupb.Envelope_MuteMemberReq:
+		s.handleMuteMember(client, payload.MuteMemberReq)
+	case *videowithyoupb.Envelope_UpdateRoomSettingsReq:
+		s.handleUpdateRoomSettings(client, payload.UpdateRoomSettingsReq)
+	case *videowithyoupb.Envelope_ReadyCheckReq:
+		s.handleReadyCheckReq(client, payload.ReadyCheckReq)
+	case *videowithyoupb.Envelope_ReadyCheckAck:
+		s.handleReadyCheckAck(client, payload.ReadyCheckAck)
+	case *videowithyoupb.Envelope_ControlIntent:
+		s.handleControlIntent(client, payload.ControlIntent)
+	case *videowithyoupb.Envelope_ControlDecision:
+		s.handleControlDecision(client, payload.ControlDecision)
+	case *videowithyoupb.Envelope_ChatMessage:
+		s.handleChatMessage(client, payload.ChatMessage)
+	case *videowithyoupb.Envelope_Reaction:
+		s.handleReaction(client, payload.Reaction)
+	case *videowithyoupb.Envelope_PlaylistUpdateReq:
+		s.handlePlaylistUpdate(client, payload.PlaylistUpdateReq)
+	case *videowithyoupb.Envelope_MemberStatus:
+		s.handleMemberStatus(client, payload.MemberStatus)
+	case *videowithyoupb.Envelope_HostState:
+		s.handleHostState(client, payload.HostState)
+	case *videowithyoupb.Envelope_FollowerReport:
+		s.handleFollowerReport(client, payload.FollowerReport)
+	case *videowithyoupb.Envelope_TimeSyncReq:
+		s.handleTimeSync(client, payload.TimeSyncReq)
+	default:
+		s.log.Printf("client %s unknown payload", client.id)
+	}
+}
+
 func (s *Server) writeLoop(client *Client, done <-chan struct{}) {
 	ticker := time.NewTicker(pingPeriod)
 	defer ticker.Stop()
+	defer close(client.writerDone)
 
 	for {
 		select {
-		case msg, ok := <-client.send:
-			_ = client.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				_ = client.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
-			if err := client.conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
-				return
+		case <-client.out.notify:
+			for {
+				msg, frame, finished := client.out.next()
+				if finished {
+					_ = client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+					_ = client.conn.WriteMessage(websocket.CloseMessage, frame)
+					_ = client.conn.Close()
+					return
+				}
+				if msg == nil {
+					break
+				}
+				msgType, data, err := encodeFrame(client, msg)
+				if err != nil {
+					s.log.Printf("client %s encode failed: %v", client.id, err)
+					continue
+				}
+				_ = client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if err := client.conn.WriteMessage(msgType, data); err != nil {
+					return
+				}
 			}
 		case <-ticker.C:
 			_ = client.conn.SetWriteDeadline(time.Now().Add(writeWait))
@@ -232,27 +670,60 @@ func (s *Server) writeLoop(client *Client, done <-chan struct{}) {
 	}
 }
 
-func (s *Server) handleCreateRoom(client *Client, _ *videowithyoupb.CreateRoomReq) {
+func (s *Server) handleCreateRoom(client *Client, req *videowithyoupb.CreateRoomReq) {
+	policy := req.GetJoinPolicy()
+	password := req.GetPassword()
+	if policy == videowithyoupb.JoinPolicy_JOIN_POLICY_OPEN && password != "" {
+		policy = videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD
+	}
+	if policy == videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD && password == "" {
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, req.GetRequestId(), "password required")
+		return
+	}
+	var scheduled *premiere
+	if req.GetPremiere() != nil {
+		var reason string
+		scheduled, reason = parsePremiere(req.GetPremiere(), time.Now())
+		if scheduled == nil {
+			s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, req.GetRequestId(), reason)
+			return
+		}
+	}
+	s.withdrawPendingJoin(client)
+	s.leaveHome(client)
+
 	roomID := randomID()
 	roomCode := s.uniqueRoomCode(6)
 
 	room := &Room{
-		id:      roomID,
-		code:    roomCode,
-		hostID:  client.id,
-		members: map[string]*Client{client.id: client},
+		id:              roomID,
+		code:            roomCode,
+		hostID:          client.id,
+		members:         map[string]*Client{client.id: client},
 		lastHostStateAt: time.Now(),
+		joinPolicy:      policy,
+		pending:         make(map[string]*pendingJoin),
+		banned:          make(map[string]struct{}),
+		bannedIPs:       make(map[string]struct{}),
+		muted:           make(map[string]struct{}),
+		premiere:        scheduled,
+	}
+	if password != "" {
+		room.passwordSalt = randomBytes(16)
+		room.passwordHash = hashPassword(room.passwordSalt, password)
 	}
 	client.roomID = roomID
 	client.isHost = true
 	client.active = true
+	client.joinedAt = time.Now()
 
 	s.mu.Lock()
 	s.rooms[roomID] = room
 	s.roomCodes[roomCode] = roomID
+	s.armPremiereLocked(room)
 	s.mu.Unlock()
 
-	s.log.Printf("room created %s (%s) host=%s", roomID, roomCode, client.id)
+	s.log.Printf("room created %s (%s) host=%s policy=%s", roomID, roomCode, client.id, policy)
 
 	resp := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_CreateRoomResp{
@@ -260,6 +731,7 @@ func (s *Server) handleCreateRoom(client *Client, _ *videowithyoupb.CreateRoomRe
 				RoomId:       roomID,
 				RoomCode:     roomCode,
 				ServerTimeMs: time.Now().UnixMilli(),
+				RequestId:    req.GetRequestId(),
 			},
 		},
 	}
@@ -271,47 +743,365 @@ func (s *Server) handleJoinRoom(client *Client, req *videowithyoupb.JoinRoomReq)
 	if req == nil {
 		return
 	}
+	s.withdrawPendingJoin(client)
+	if s.routeJoin(client, req) {
+		return
+	}
 
 	s.mu.Lock()
 	roomID, ok := s.roomCodes[req.RoomCode]
 	if !ok {
 		s.mu.Unlock()
-		s.sendError(client, "room not found")
+		s.joinFailed(client)
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, req.RequestId, "room not found")
 		return
 	}
 	room := s.rooms[roomID]
 	if room == nil {
 		s.mu.Unlock()
-		s.sendError(client, "room not found")
+		s.joinFailed(client)
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, req.RequestId, "room not found")
+		return
+	}
+	if isBannedLocked(room, client) {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_BANNED, req.RequestId, "banned from room")
 		return
 	}
+	switch room.joinPolicy {
+	case videowithyoupb.JoinPolicy_JOIN_POLICY_PASSWORD:
+		if !room.checkPassword(req.Password) {
+			s.mu.Unlock()
+			s.joinFailed(client)
+			s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_WRONG_PASSWORD, req.RequestId, "wrong room password")
+			return
+		}
+	case videowithyoupb.JoinPolicy_JOIN_POLICY_APPROVAL:
+		room.pending[client.id] = &pendingJoin{
+			client:      client,
+			requestID:   req.RequestId,
+			requestedAt: time.Now(),
+		}
+		client.pendingRoomID = room.id
+		host := room.members[room.hostID]
+		name := client.name
+		s.mu.Unlock()
+
+		s.log.Printf("room join pending %s (%s) member=%s", roomID, room.code, client.id)
+		resp := &videowithyoupb.Envelope{
+			Payload: &videowithyoupb.Envelope_JoinPendingResp{
+				JoinPendingResp: &videowithyoupb.JoinPendingResp{
+					RoomId:       roomID,
+					RequestId:    req.RequestId,
+					ServerTimeMs: time.Now().UnixMilli(),
+				},
+			},
+		}
+		_ = s.sendEnvelope(client, resp)
+		if host != nil {
+			s.sendJoinRequestNotice(host, roomID, client.id, name, false)
+		}
+		return
+	}
+	admitMemberLocked(room, client)
+	s.mu.Unlock()
+
+	s.completeJoin(room, client, req.RequestId)
+}
+
+// admitMemberLocked adds client to room as a follower.
+func admitMemberLocked(room *Room, client *Client) {
 	room.members[client.id] = client
-	client.roomID = roomID
+	client.roomID = room.id
 	client.isHost = false
 	client.active = true
-	s.mu.Unlock()
+	client.joinedAt = time.Now()
+}
 
-	s.log.Printf("room join %s (%s) member=%s", roomID, room.code, client.id)
+func (s *Server) completeJoin(room *Room, client *Client, requestID string) {
+	s.log.Printf("room join %s (%s) member=%s", room.id, room.code, client.id)
+
+	s.mu.RLock()
+	hostID := room.hostID
+	latest := currentStateLocked(room, time.Now())
+	recentChat := room.chat.recent()
+	s.mu.RUnlock()
 
 	resp := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_JoinRoomResp{
 			JoinRoomResp: &videowithyoupb.JoinRoomResp{
-				RoomId:       roomID,
-				HostId:       room.hostID,
+				RoomId:       room.id,
+				HostId:       hostID,
 				ServerTimeMs: time.Now().UnixMilli(),
+				RequestId:    requestID,
+				RecentChat:   recentChat,
 			},
 		},
 	}
 	_ = s.sendEnvelope(client, resp)
 	s.broadcastRoomSnapshot(room)
 
-	if room.latestState != nil {
-		s.broadcastHostState(room, room.latestState)
+	if latest != nil {
+		s.broadcastHostState(room, latest)
+	}
+	s.sendReactionBatch(room, []*Client{client})
+}
+
+func (s *Server) handleJoinDecision(client *Client, req *videowithyoupb.JoinDecision) {
+	if req == nil {
+		return
+	}
+
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, req.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	pending := room.pending[req.MemberId]
+	if pending == nil {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MEMBER_NOT_FOUND, req.RequestId, "join request not found")
+		return
+	}
+	delete(room.pending, req.MemberId)
+	joiner := pending.client
+	joiner.pendingRoomID = ""
+	if req.Approve {
+		admitMemberLocked(room, joiner)
+	}
+	s.mu.Unlock()
+
+	if !req.Approve {
+		s.log.Printf("room join rejected %s member=%s", room.id, joiner.id)
+		s.sendError(joiner, videowithyoupb.ErrorCode_ERROR_CODE_JOIN_REJECTED, pending.requestID, "join request rejected")
+		return
+	}
+	s.completeJoin(room, joiner, pending.requestID)
+}
+
+// hostRoomLocked returns the room roomID if client is its host. Otherwise it
+// returns the error to report.
+func (s *Server) hostRoomLocked(client *Client, roomID string) (*Room, videowithyoupb.ErrorCode, string) {
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != roomID {
+		return nil, videowithyoupb.ErrorCode_ERROR_CODE_NOT_IN_ROOM, "not in room"
+	}
+	if room.hostID != client.id {
+		return nil, videowithyoupb.ErrorCode_ERROR_CODE_NOT_HOST, "not host"
+	}
+	return room, videowithyoupb.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+}
+
+// handleModerateMember removes a member (or a knocking joiner) from the room
+// on the host's behalf. Banned identities cannot rejoin the room.
+func (s *Server) handleModerateMember(client *Client, roomID, memberID, requestID string, ban bool) {
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, roomID)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, requestID, message)
+		return
+	}
+	target := room.members[memberID]
+	targetRequestID := ""
+	if target == nil {
+		if pending := room.pending[memberID]; pending != nil {
+			delete(room.pending, memberID)
+			pending.client.pendingRoomID = ""
+			target = pending.client
+			targetRequestID = pending.requestID
+		}
+	}
+	if target == nil || target.id == client.id {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MEMBER_NOT_FOUND, requestID, "member not found")
+		return
+	}
+	if ban {
+		banRoomLocked(room, target)
+	}
+	s.mu.Unlock()
+
+	s.removeClientFromRoom(target, "")
+	if ban {
+		s.log.Printf("room ban %s member=%s identity=%s ip=%s", room.id, target.id, target.identity, target.ip)
+		s.sendError(target, videowithyoupb.ErrorCode_ERROR_CODE_BANNED, targetRequestID, "banned by host")
+		return
+	}
+	s.log.Printf("room kick %s member=%s", room.id, target.id)
+	s.sendError(target, videowithyoupb.ErrorCode_ERROR_CODE_KICKED, targetRequestID, "removed by host")
+}
+
+func (s *Server) handleMuteMember(client *Client, req *videowithyoupb.MuteMemberReq) {
+	if req == nil {
+		return
+	}
+
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, req.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	target := room.members[req.MemberId]
+	if target == nil || target.id == client.id {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MEMBER_NOT_FOUND, req.RequestId, "member not found")
+		return
+	}
+	if req.Muted {
+		room.muted[muteKey(target)] = struct{}{}
+	} else {
+		delete(room.muted, muteKey(target))
+	}
+	s.mu.Unlock()
+
+	s.log.Printf("room mute %s member=%s muted=%t", room.id, target.id, req.Muted)
+	s.broadcastRoomSnapshot(room)
+}
+
+// banRoomLocked bans target's identity and IP from room.
+func banRoomLocked(room *Room, target *Client) {
+	if target.identity != "" {
+		room.banned[target.identity] = struct{}{}
+	}
+	if target.ip != "" {
+		room.bannedIPs[target.ip] = struct{}{}
+	}
+}
+
+// isBannedLocked reports whether client's identity or IP is banned from room.
+func isBannedLocked(room *Room, client *Client) bool {
+	if _, banned := room.banned[client.identity]; banned && client.identity != "" {
+		return true
 	}
+	_, banned := room.bannedIPs[client.ip]
+	return banned && client.ip != ""
 }
 
-func (s *Server) handleLeaveRoom(client *Client, _ *videowithyoupb.LeaveRoomReq) {
-	s.removeClientFromRoom(client)
+// muteKey is what a mute on client is filed under: its identity, or its member
+// id if it sent none.
+func muteKey(client *Client) string {
+	if client.identity == "" {
+		return "member:" + client.id
+	}
+	return client.identity
+}
+
+// isMutedLocked reports whether client may not send messages to the room.
+func isMutedLocked(room *Room, client *Client) bool {
+	_, muted := room.muted[muteKey(client)]
+	return muted
+}
+
+// withdrawPendingJoin drops client's outstanding knock, if any, and tells the
+// host it was withdrawn.
+func (s *Server) withdrawPendingJoin(client *Client) {
+	s.mu.Lock()
+	room := s.rooms[client.pendingRoomID]
+	client.pendingRoomID = ""
+	if room == nil || room.pending[client.id] == nil {
+		s.mu.Unlock()
+		return
+	}
+	delete(room.pending, client.id)
+	host := room.members[room.hostID]
+	s.mu.Unlock()
+
+	if host != nil {
+		s.sendJoinRequestNotice(host, room.id, client.id, client.name, true)
+	}
+}
+
+// notifyPendingJoins replays outstanding knocks to the current host, e.g.
+// after a host change or resume.
+func (s *Server) notifyPendingJoins(room *Room) {
+	type knock struct {
+		id   string
+		name string
+	}
+	s.mu.RLock()
+	host := room.members[room.hostID]
+	knocks := make([]knock, 0, len(room.pending))
+	for id, pending := range room.pending {
+		knocks = append(knocks, knock{id: id, name: pending.client.name})
+	}
+	s.mu.RUnlock()
+
+	if host == nil {
+		return
+	}
+	for _, item := range knocks {
+		s.sendJoinRequestNotice(host, room.id, item.id, item.name, false)
+	}
+}
+
+func (s *Server) sendJoinRequestNotice(host *Client, roomID, memberID, name string, withdrawn bool) {
+	env := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_JoinRequestNotice{
+			JoinRequestNotice: &videowithyoupb.JoinRequestNotice{
+				RoomId:       roomID,
+				MemberId:     memberID,
+				DisplayName:  name,
+				Withdrawn:    withdrawn,
+				ServerTimeMs: time.Now().UnixMilli(),
+			},
+		},
+	}
+	_ = s.sendEnvelope(host, env)
+}
+
+// dropPendingLocked clears every knock on a room that is going away and
+// returns the joiners so they can be told.
+func dropPendingLocked(room *Room) []*pendingJoin {
+	dropped := make([]*pendingJoin, 0, len(room.pending))
+	for id, pending := range room.pending {
+		pending.client.pendingRoomID = ""
+		dropped = append(dropped, pending)
+		delete(room.pending, id)
+	}
+	return dropped
+}
+
+func (r *Room) checkPassword(password string) bool {
+	if len(r.passwordHash) == 0 {
+		return true
+	}
+	return subtle.ConstantTimeCompare(hashPassword(r.passwordSalt, password), r.passwordHash) == 1
+}
+
+func (s *Server) handleLeaveRoom(client *Client, req *videowithyoupb.LeaveRoomReq) {
+	s.withdrawPendingJoin(client)
+	s.removeClientFromRoom(client, req.GetSuccessorId())
+}
+
+func (s *Server) handleTransferHost(client *Client, req *videowithyoupb.TransferHostReq) {
+	if req == nil {
+		return
+	}
+
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, req.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	target := room.members[req.TargetId]
+	if target == nil || target.id == client.id {
+		s.mu.Unlock()
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_MEMBER_NOT_FOUND, req.RequestId, "member not found")
+		return
+	}
+	s.setHostLocked(room, target)
+	s.mu.Unlock()
+
+	s.log.Printf("room host transfer %s from=%s to=%s", room.id, client.id, target.id)
+	s.broadcastRoomSnapshot(room)
+	s.notifyPendingJoins(room)
 }
 
 func (s *Server) handleHostState(client *Client, state *videowithyoupb.HostState) {
@@ -329,11 +1119,41 @@ func (s *Server) handleHostState(client *Client, state *videowithyoupb.HostState
 		s.mu.Unlock()
 		return
 	}
+	now := time.Now()
+	if premierePendingLocked(room, now) {
+		// The premiere timeline holds the room until the start.
+		s.mu.Unlock()
+		return
+	}
+	accept, skew, notifySkew := s.sanitizeHostStateLocked(room, client, state, now)
+	if !accept {
+		last := room.lastSeq
+		s.mu.Unlock()
+		s.log.Printf("host state dropped room=%s seq=%d last=%d", room.id, state.Seq, last)
+		return
+	}
+	// Playback state changes constantly; persist the first one and then at
+	// most every statePersistInterval.
+	firstState := room.latestState == nil
+	mediaChanged := mediaKey(currentStateLocked(room, now).GetMedia()) != mediaKey(state.GetMedia())
 	room.latestState = state
-	room.lastHostStateAt = time.Now()
+	room.lastHostStateAt = now
+	if firstState || time.Since(room.persistedAt) >= statePersistInterval {
+		s.putRoomLocked(room)
+	}
 	s.mu.Unlock()
 
+	if notifySkew {
+		s.log.Printf("host clock skew room=%s host=%s skew=%s", room.id, client.id, skew)
+		s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_CLOCK_SKEW, "", "host clock is "+skew.Round(time.Millisecond).String()+" off; resync time")
+	}
 	s.broadcastHostState(room, state)
+	if mediaChanged {
+		s.mu.RLock()
+		targets := connectedMembersLocked(room)
+		s.mu.RUnlock()
+		s.sendReactionBatch(room, targets)
+	}
 }
 
 func (s *Server) handleMemberStatus(client *Client, status *videowithyoupb.MemberStatus) {
@@ -349,9 +1169,15 @@ func (s *Server) handleMemberStatus(client *Client, status *videowithyoupb.Membe
 		s.mu.Unlock()
 		return
 	}
+	changed := client.active != status.Active || client.buffering != status.Buffering
 	client.active = status.Active
+	client.buffering = status.Buffering
 	s.mu.Unlock()
-	s.log.Printf("member status room=%s member=%s active=%t", room.id, client.id, status.Active)
+	if !changed {
+		return
+	}
+	s.log.Printf("member status room=%s member=%s active=%t buffering=%t", room.id, client.id, status.Active, status.Buffering)
+	s.checkBufferHold(room)
 }
 
 func (s *Server) broadcastHostState(room *Room, state *videowithyoupb.HostState) {
@@ -362,7 +1188,7 @@ func (s *Server) broadcastHostState(room *Room, state *videowithyoupb.HostState)
 		if member.id == room.hostID {
 			continue
 		}
-		if !member.active {
+		if !member.active || !member.connected {
 			continue
 		}
 		targets = append(targets, member)
@@ -391,12 +1217,12 @@ func (s *Server) broadcastHostState(room *Room, state *videowithyoupb.HostState)
 
 	count := 0
 	for _, member := range targets {
-		select {
-		case member.send <- payload:
+		if s.enqueue(member, payload, true) {
 			count++
-		default:
 		}
 	}
+	s.metrics.broadcasts.Add(1)
+	s.metrics.broadcastSends.Add(uint64(count))
 
 	s.log.Printf("broadcast state room=%s followers=%d", room.id, count)
 }
@@ -405,6 +1231,7 @@ func (s *Server) handleTimeSync(client *Client, req *videowithyoupb.TimeSyncReq)
 	if req == nil {
 		return
 	}
+	s.metrics.timeSyncRequests.Add(1)
 	t2 := time.Now().UnixMilli()
 	t3 := time.Now().UnixMilli()
 	resp := &videowithyoupb.Envelope{
@@ -426,11 +1253,7 @@ func (s *Server) hostIdleLoop() {
 
 	for range ticker.C {
 		now := time.Now()
-		type roomClose struct {
-			id      string
-			members []*Client
-		}
-		toClose := make([]roomClose, 0)
+		toClose := make([]closedRoom, 0)
 
 		s.mu.Lock()
 		for _, room := range s.rooms {
@@ -438,34 +1261,52 @@ func (s *Server) hostIdleLoop() {
 				continue
 			}
 			last := room.lastHostStateAt
-			if last.IsZero() {
+			if last.IsZero() || premierePendingLocked(room, now) {
 				continue
 			}
 			if now.Sub(last) < s.hostIdleTimeout {
 				continue
 			}
-
-			members := make([]*Client, 0, len(room.members))
-			for _, member := range room.members {
-				member.roomID = ""
-				member.isHost = false
-				members = append(members, member)
-			}
-			delete(s.rooms, room.id)
-			delete(s.roomCodes, room.code)
-			toClose = append(toClose, roomClose{id: room.id, members: members})
+			toClose = append(toClose, s.closeRoomLocked(room, closeReasonHostIdle))
 		}
 		s.mu.Unlock()
 
 		for _, item := range toClose {
-			for _, member := range item.members {
-				s.sendError(member, "room closed (host idle)")
-			}
+			s.notifyRoomClosed(item, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_CLOSED_IDLE, "room closed (host idle)")
 			s.log.Printf("room closed idle %s", item.id)
 		}
 	}
 }
 
+// closedRoom is what is left to notify once a room has been closed.
+type closedRoom struct {
+	id      string
+	members []*Client
+	pending []*pendingJoin
+}
+
+// closeRoomLocked detaches every member and removes room. It is called with
+// s.mu held; the caller notifies the returned clients after unlocking.
+func (s *Server) closeRoomLocked(room *Room, reason string) closedRoom {
+	members := make([]*Client, 0, len(room.members))
+	for _, member := range room.members {
+		member.roomID = ""
+		member.isHost = false
+		members = append(members, member)
+	}
+	s.deleteRoomLocked(room, reason)
+	return closedRoom{id: room.id, members: members, pending: dropPendingLocked(room)}
+}
+
+func (s *Server) notifyRoomClosed(item closedRoom, code videowithyoupb.ErrorCode, message string) {
+	for _, member := range item.members {
+		s.sendError(member, code, "", message)
+	}
+	for _, pending := range item.pending {
+		s.sendError(pending.client, code, pending.requestID, message)
+	}
+}
+
 func (s *Server) broadcastRoomSnapshot(room *Room) {
 	targets := make([]*Client, 0, len(room.members))
 	snapshot := &videowithyoupb.Envelope{
@@ -475,14 +1316,30 @@ func (s *Server) broadcastRoomSnapshot(room *Room) {
 				RoomCode:     room.code,
 				HostId:       room.hostID,
 				Members:      nil,
-				LatestState:  room.latestState,
 				ServerTimeMs: time.Now().UnixMilli(),
 			},
 		},
 	}
 	s.mu.RLock()
+	snapshot.GetRoomSnapshot().LatestState = currentStateLocked(room, time.Now())
+	if room.premiere != nil {
+		snapshot.GetRoomSnapshot().Premiere = room.premiere.proto()
+	}
 	snapshot.GetRoomSnapshot().Members = s.buildMembers(room)
+	snapshot.GetRoomSnapshot().Settings = &videowithyoupb.RoomSettings{
+		JoinPolicy:          room.joinPolicy,
+		PauseOnBuffering:    room.pauseOnBuffering,
+		ControlMode:         room.controlMode,
+		MembersEditPlaylist: room.membersEditPlaylist,
+		Public:              room.public,
+		Title:               room.title,
+		Description:         room.description,
+	}
+	snapshot.GetRoomSnapshot().Playlist = room.playlist.proto()
 	for _, member := range room.members {
+		if !member.connected {
+			continue
+		}
 		targets = append(targets, member)
 	}
 	s.mu.RUnlock()
@@ -495,14 +1352,13 @@ func (s *Server) broadcastRoomSnapshot(room *Room) {
 
 	count := 0
 	for _, member := range targets {
-		select {
-		case member.send <- payload:
+		if s.enqueue(member, payload, false) {
 			count++
-		default:
 		}
 	}
 
 	s.log.Printf("room snapshot room=%s members=%d", room.id, count)
+	s.persistRoom(room)
 }
 
 func (s *Server) buildMembers(room *Room) []*videowithyoupb.Member {
@@ -512,36 +1368,39 @@ func (s *Server) buildMembers(room *Room) []*videowithyoupb.Member {
 			MemberId:    member.id,
 			DisplayName: member.name,
 			IsHost:      member.id == room.hostID,
+			Muted:       isMutedLocked(room, member),
 		})
 	}
 	return members
 }
 
 func (s *Server) sendEnvelope(client *Client, env *videowithyoupb.Envelope) error {
+	if capability := envelopeCapability(env); capability != "" && !client.caps.has(capability) {
+		return nil
+	}
 	payload, err := proto.Marshal(env)
 	if err != nil {
 		return err
 	}
-	select {
-	case client.send <- payload:
-	default:
-	}
+	s.enqueue(client, payload, false)
 	return nil
 }
 
-func (s *Server) sendError(client *Client, message string) {
+func (s *Server) sendError(client *Client, code videowithyoupb.ErrorCode, requestID, message string) {
 	env := &videowithyoupb.Envelope{
 		Payload: &videowithyoupb.Envelope_ErrorResp{
 			ErrorResp: &videowithyoupb.ErrorResp{
 				Message:      message,
 				ServerTimeMs: time.Now().UnixMilli(),
+				Code:         code,
+				RequestId:    requestID,
 			},
 		},
 	}
 	_ = s.sendEnvelope(client, env)
 }
 
-func (s *Server) removeClientFromRoom(client *Client) {
+func (s *Server) removeClientFromRoom(client *Client, successorID string) {
 	s.mu.Lock()
 	room := s.rooms[client.roomID]
 	if room == nil {
@@ -550,43 +1409,162 @@ func (s *Server) removeClientFromRoom(client *Client) {
 	}
 	isHost := room.hostID == client.id
 	delete(room.members, client.id)
+	if _, ok := room.reports[client.id]; ok {
+		delete(room.reports, client.id)
+		room.reportsDirty = true
+	}
 	client.roomID = ""
 	client.isHost = false
 
 	if len(room.members) == 0 {
-		delete(s.rooms, room.id)
-		delete(s.roomCodes, room.code)
+		s.deleteRoomLocked(room, closeReasonEmpty)
+		dropped := dropPendingLocked(room)
 		s.mu.Unlock()
+		for _, pending := range dropped {
+			s.sendError(pending.client, videowithyoupb.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, pending.requestID, "room closed")
+		}
 		s.log.Printf("room removed %s", room.id)
 		return
 	}
 
 	if isHost {
-		remaining := make([]*Client, 0, len(room.members))
-		for _, member := range room.members {
-			member.roomID = ""
-			member.isHost = false
-			remaining = append(remaining, member)
+		successor := room.members[successorID]
+		if successor == nil {
+			successor = pickSuccessor(room)
 		}
-		delete(s.rooms, room.id)
-		delete(s.roomCodes, room.code)
+		s.setHostLocked(room, successor)
 		s.mu.Unlock()
 
-		for _, member := range remaining {
-			s.sendError(member, "room closed (host left)")
-		}
-		s.log.Printf("room closed %s host=%s", room.id, client.id)
+		s.log.Printf("room host migrated %s from=%s to=%s", room.id, client.id, successor.id)
+		s.broadcastRoomSnapshot(room)
+		s.notifyPendingJoins(room)
+		s.checkBufferHold(room)
+		s.updateReadyCheck(room)
 		return
 	}
 	s.mu.Unlock()
 
 	s.log.Printf("room leave %s member=%s", room.id, client.id)
 	s.broadcastRoomSnapshot(room)
+	s.checkBufferHold(room)
+	s.updateReadyCheck(room)
+}
+
+// setHostLocked hands the host role to target. The idle timer restarts so the
+// new host has a full window to start reporting HostState.
+func (s *Server) setHostLocked(room *Room, target *Client) {
+	if previous := room.members[room.hostID]; previous != nil {
+		previous.isHost = false
+	}
+	room.hostID = target.id
+	room.lastHostStateAt = time.Now()
+	room.lastSeq = 0
+	target.isHost = true
+	// The new host does not follow anyone; the next summary goes to it.
+	delete(room.reports, target.id)
+	room.reportsDirty = true
+}
+
+// pickSuccessor returns the longest-connected active member. Members whose
+// endpoint is inactive or whose slot is only held for resume rank lower. The
+// room must have at least one member.
+func pickSuccessor(room *Room) *Client {
+	var best *Client
+	for _, member := range room.members {
+		if best == nil {
+			best = member
+			continue
+		}
+		if rank, bestRank := successorRank(member), successorRank(best); rank != bestRank {
+			if rank > bestRank {
+				best = member
+			}
+			continue
+		}
+		if member.joinedAt.Before(best.joinedAt) {
+			best = member
+		}
+	}
+	return best
+}
+
+func successorRank(member *Client) int {
+	rank := 0
+	if member.connected {
+		rank += 2
+	}
+	if member.active {
+		rank++
+	}
+	return rank
 }
 
 func (s *Server) cleanupClient(client *Client) {
-	s.removeClientFromRoom(client)
-	close(client.send)
+	s.withdrawPendingJoin(client)
+
+	s.mu.Lock()
+	if client.replaced || s.shuttingDown.Load() {
+		s.mu.Unlock()
+		return
+	}
+	if s.resumeGrace > 0 && client.roomID != "" && s.rooms[client.roomID] != nil {
+		client.connected = false
+		client.expiresAt = time.Now().Add(s.resumeGrace)
+		room := s.rooms[client.roomID]
+		s.mu.Unlock()
+		s.log.Printf("client %s disconnected, holding slot in room %s for %s", client.id, room.id, s.resumeGrace)
+		// A held slot cannot report that it stopped buffering, or
+		// acknowledge a ready check.
+		s.checkBufferHold(room)
+		s.updateReadyCheck(room)
+		return
+	}
+	if s.resumeGrace > 0 && client.home != "" {
+		// The room's instance holds the slot; the token stays here so the
+		// member can resume through this instance.
+		client.connected = false
+		client.expiresAt = time.Now().Add(s.resumeGrace)
+		s.mu.Unlock()
+		s.log.Printf("client %s disconnected, instance %s holds its slot", client.id, client.home)
+		return
+	}
+	delete(s.sessions, client.resumeToken)
+	client.connected = false
+	client.home = ""
+	s.mu.Unlock()
+
+	s.removeClientFromRoom(client, "")
+	client.out.close()
+}
+
+// sessionExpiryLoop drops held room slots whose resume window has passed.
+func (s *Server) sessionExpiryLoop() {
+	ticker := time.NewTicker(sessionCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		now := time.Now()
+		expired := make([]*Client, 0)
+
+		s.mu.Lock()
+		for token, client := range s.sessions {
+			if client.connected || now.Before(client.expiresAt) {
+				continue
+			}
+			delete(s.sessions, token)
+			if s.stubs[client.id] == client {
+				delete(s.stubs, client.id)
+			}
+			client.home = ""
+			expired = append(expired, client)
+		}
+		s.mu.Unlock()
+
+		for _, client := range expired {
+			s.log.Printf("client %s resume window expired", client.id)
+			s.removeClientFromRoom(client, "")
+		}
+	}
 }
 
 func (s *Server) uniqueRoomCode(length int) string {
@@ -595,7 +1573,7 @@ func (s *Server) uniqueRoomCode(length int) string {
 		s.mu.RLock()
 		_, exists := s.roomCodes[code]
 		s.mu.RUnlock()
-		if !exists {
+		if !exists && s.claimRoomCode(code) {
 			return code
 		}
 	}
@@ -610,9 +1588,20 @@ func randomRoomCode(length int) string {
 }
 
 func randomID() string {
-	data := make([]byte, 16)
+	return hex.EncodeToString(randomBytes(16))
+}
+
+func randomBytes(n int) []byte {
+	data := make([]byte, n)
 	_, _ = rand.Read(data)
-	return hex.EncodeToString(data)
+	return data
+}
+
+func hashPassword(salt []byte, password string) []byte {
+	sum := sha256.New()
+	sum.Write(salt)
+	sum.Write([]byte(password))
+	return sum.Sum(nil)
 }
 
 func randomInt(max int) int {
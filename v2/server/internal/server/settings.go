@@ -0,0 +1,74 @@
+package server
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// handleUpdateRoomSettings applies the settings the host set in req and
+// sends everyone the new snapshot.
+func (s *Server) handleUpdateRoomSettings(client *Client, req *videowithyoupb.UpdateRoomSettingsReq) {
+	if req == nil {
+		return
+	}
+
+	if req.ControlMode != nil {
+		if _, ok := videowithyoupb.ControlMode_name[int32(*req.ControlMode)]; !ok {
+			s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, req.RequestId, "unknown control mode")
+			return
+		}
+	}
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if utf8.RuneCountInString(title) > roomTitleMaxRunes {
+			s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, req.RequestId, "title too long")
+			return
+		}
+		req.Title = &title
+	}
+	if req.Description != nil {
+		description := strings.TrimSpace(*req.Description)
+		if utf8.RuneCountInString(description) > roomDescriptionMaxRunes {
+			s.sendError(client, videowithyoupb.ErrorCode_ERROR_CODE_INVALID_REQUEST, req.RequestId, "description too long")
+			return
+		}
+		req.Description = &description
+	}
+
+	s.mu.Lock()
+	room, code, message := s.hostRoomLocked(client, req.RoomId)
+	if room == nil {
+		s.mu.Unlock()
+		s.sendError(client, code, req.RequestId, message)
+		return
+	}
+	if req.PauseOnBuffering != nil {
+		room.pauseOnBuffering = *req.PauseOnBuffering
+	}
+	if req.ControlMode != nil {
+		room.controlMode = *req.ControlMode
+	}
+	if req.MembersEditPlaylist != nil {
+		room.membersEditPlaylist = *req.MembersEditPlaylist
+	}
+	if req.Public != nil {
+		room.public = *req.Public
+	}
+	if req.Title != nil {
+		room.title = *req.Title
+	}
+	if req.Description != nil {
+		room.description = *req.Description
+	}
+	pauseOnBuffering := room.pauseOnBuffering
+	controlMode := room.controlMode
+	membersEditPlaylist := room.membersEditPlaylist
+	public := room.public
+	s.mu.Unlock()
+
+	s.log.Printf("room settings %s pause_on_buffering=%t control_mode=%s members_edit_playlist=%t public=%t", room.id, pauseOnBuffering, controlMode, membersEditPlaylist, public)
+	s.broadcastRoomSnapshot(room)
+	s.checkBufferHold(room)
+}
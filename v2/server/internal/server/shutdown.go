@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+// Shutdown stops accepting websocket connections, tells every client to
+// reconnect after reconnectAfter, waits for their outboxes to drain (or ctx
+// to end) and then closes their sockets. Rooms are written to the store
+// first so a restarted server can restore them; the store itself is left
+// open for CloseStore.
+func (s *Server) Shutdown(ctx context.Context, reconnectAfter time.Duration) error {
+	if s.shuttingDown.Swap(true) {
+		return nil
+	}
+	s.SetReady(false)
+
+	s.mu.Lock()
+	for _, room := range s.rooms {
+		s.putRoomLocked(room)
+	}
+	targets := make([]*Client, 0, len(s.sessions))
+	for _, client := range s.sessions {
+		if client.connected {
+			targets = append(targets, client)
+		}
+	}
+	s.mu.Unlock()
+
+	message := "server restarting"
+	if reconnectAfter > 0 {
+		message = fmt.Sprintf("server restarting, reconnect in %d s", int(reconnectAfter.Round(time.Second)/time.Second))
+	}
+	notice := &videowithyoupb.Envelope{
+		Payload: &videowithyoupb.Envelope_ServerNotice{
+			ServerNotice: &videowithyoupb.ServerNotice{
+				Message:          message,
+				ServerTimeMs:     time.Now().UnixMilli(),
+				ReconnectAfterMs: reconnectAfter.Milliseconds(),
+			},
+		},
+	}
+	for _, client := range targets {
+		_ = s.sendEnvelope(client, notice)
+	}
+	s.log.Printf("shutdown: notified %d clients, reconnect after %s", len(targets), reconnectAfter)
+
+	closeFrame := websocket.FormatCloseMessage(websocket.CloseServiceRestart, message)
+	for _, client := range targets {
+		client.out.finish(closeFrame)
+	}
+	var err error
+	for _, client := range targets {
+		if client.writerDone == nil {
+			continue
+		}
+		select {
+		case <-client.writerDone:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	if err != nil {
+		s.log.Printf("shutdown: gave up draining: %v", err)
+	}
+
+	for _, client := range targets {
+		if client.conn != nil {
+			_ = client.conn.Close()
+		}
+	}
+	return err
+}
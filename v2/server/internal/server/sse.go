@@ -0,0 +1,222 @@
+package server
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// The HTTP fallback serves clients whose network blocks websockets. A GET
+// opens a server-sent event stream, which lives as long as a websocket
+// would: its helloHeader carries the ClientHello and each event's data one
+// Envelope. Envelopes from the client arrive as POSTs naming the stream's
+// client_id, authorized by its resume token. The hello and the token stay
+// out of the URL, which proxies and access logs record.
+
+// helloHeader holds the base64 ClientHello that opens an event stream.
+const helloHeader = "X-VideoWithYou-Hello"
+
+// sseStream is the open event stream of an HTTP fallback client.
+type sseStream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+	// postMu makes the client's POSTs take effect one at a time, in the
+	// order they arrive, like reads from a websocket.
+	postMu sync.Mutex
+}
+
+// HandleSSE serves the HTTP fallback: GET opens a client's event stream and
+// POST delivers one envelope from it.
+func (s *Server) HandleSSE(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.serveSSE(w, r)
+	case http.MethodPost:
+		s.handleSSEPost(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) serveSSE(w http.ResponseWriter, r *http.Request) {
+	if s.shuttingDown.Load() {
+		http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	if !s.reserveConnection() {
+		http.Error(w, "too many connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.metrics.connections.Add(-1)
+
+	client := &Client{
+		id:         randomID(),
+		out:        newOutbox(),
+		json:       r.URL.Query().Get("encoding") == "json",
+		active:     true,
+		connected:  true,
+		writerDone: make(chan struct{}),
+		sse:        &sseStream{w: w, rc: http.NewResponseController(w)},
+		ip:         s.clientIP(r),
+	}
+	data, err := base64.StdEncoding.DecodeString(r.Header.Get(helloHeader))
+	if err != nil {
+		http.Error(w, "bad hello", http.StatusBadRequest)
+		return
+	}
+	msgType := websocket.BinaryMessage
+	if client.json {
+		msgType = websocket.TextMessage
+	}
+	env, err := decodeEnvelope(client, msgType, data)
+	if err != nil || env.GetClientHello() == nil {
+		http.Error(w, "bad hello", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Keep reverse proxies from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := client.sse.rc.Flush(); err != nil {
+		s.log.Printf("sse stream unsupported: %v", err)
+		return
+	}
+
+	if err := s.handleHello(client, env); err != nil {
+		s.log.Printf("hello failed: %v", err)
+		return
+	}
+
+	s.registerLocal(client)
+	s.sseWriteLoop(client, r.Context().Done())
+
+	s.forgetLocal(client)
+	s.cleanupClient(client)
+}
+
+func (s *Server) handleSSEPost(w http.ResponseWriter, r *http.Request) {
+	clientID := r.URL.Query().Get("client_id")
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	s.mu.RLock()
+	client := s.local[clientID]
+	authorized := client != nil && client.sse != nil &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(client.resumeToken)) == 1
+	s.mu.RUnlock()
+	if !authorized {
+		// The stream ended, or went to another instance; the client
+		// reconnects.
+		http.Error(w, "no such session", http.StatusNotFound)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxMessageBytes))
+	if err != nil {
+		http.Error(w, "message too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	msgType := websocket.BinaryMessage
+	if client.json {
+		msgType = websocket.TextMessage
+	}
+	env, err := decodeEnvelope(client, msgType, data)
+	if err != nil {
+		s.log.Printf("client %s bad envelope: %v", client.id, err)
+		http.Error(w, "bad envelope", http.StatusBadRequest)
+		return
+	}
+
+	client.sse.postMu.Lock()
+	if s.allowRequest(client, env) && !s.relayToHome(client, env, data) {
+		s.dispatch(client, env)
+	}
+	client.sse.postMu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sseWriteLoop is writeLoop for an event stream. A close frame becomes a
+// close event carrying its reason.
+func (s *Server) sseWriteLoop(client *Client, done <-chan struct{}) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	defer close(client.writerDone)
+
+	for {
+		select {
+		case <-client.out.notify:
+			for {
+				msg, frame, finished := client.out.next()
+				if finished {
+					reason := []byte{}
+					if len(frame) > 2 {
+						reason = frame[2:]
+					}
+					_ = client.sse.event("close", reason)
+					return
+				}
+				if msg == nil {
+					break
+				}
+				if err := client.sse.send(client, msg); err != nil {
+					return
+				}
+			}
+		case <-ticker.C:
+			if err := client.sse.comment("ping"); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// send writes payload, a binary Envelope, as an event: base64 for binary
+// clients, protojson for JSON ones.
+func (e *sseStream) send(client *Client, payload []byte) error {
+	if !client.json {
+		return e.event("", []byte(base64.StdEncoding.EncodeToString(payload)))
+	}
+	_, data, err := encodeFrame(client, payload)
+	if err != nil {
+		return err
+	}
+	return e.event("", data)
+}
+
+func (e *sseStream) event(name string, data []byte) error {
+	if bytes.ContainsAny(data, "\r\n") {
+		return errors.New("sse: multi-line event data")
+	}
+	var buf bytes.Buffer
+	if name != "" {
+		buf.WriteString("event: " + name + "\n")
+	}
+	buf.WriteString("data: ")
+	buf.Write(data)
+	buf.WriteString("\n\n")
+	return e.write(buf.Bytes())
+}
+
+func (e *sseStream) comment(text string) error {
+	return e.write([]byte(": " + text + "\n\n"))
+}
+
+func (e *sseStream) write(data []byte) error {
+	_ = e.rc.SetWriteDeadline(time.Now().Add(writeWait))
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+	return e.rc.Flush()
+}
@@ -0,0 +1,287 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	storeSnapshotFile = "rooms.json"
+	storeLogFile      = "rooms.log"
+	// storeCompactEvery is how many log entries FileStore appends before it
+	// folds them into a fresh snapshot.
+	storeCompactEvery = 1000
+)
+
+// RoomStore persists rooms so they can be restored after a server restart.
+type RoomStore interface {
+	// Load returns every stored room.
+	Load() ([]RoomRecord, error)
+	// Put inserts or replaces a room.
+	Put(record RoomRecord) error
+	// Delete removes a room. Deleting an unknown room is not an error.
+	Delete(roomID string) error
+	Close() error
+}
+
+// RoomRecord is the persisted form of a Room.
+type RoomRecord struct {
+	ID           string         `json:"id"`
+	Code         string         `json:"code"`
+	HostID       string         `json:"host_id"`
+	JoinPolicy   int32          `json:"join_policy"`
+	PasswordSalt []byte         `json:"password_salt,omitempty"`
+	PasswordHash []byte         `json:"password_hash,omitempty"`
+	Banned       []string       `json:"banned,omitempty"`
+	Muted        []string       `json:"muted,omitempty"`
+	Members      []MemberRecord `json:"members"`
+	// BannedIPs holds the addresses banned members connected from.
+	BannedIPs []string `json:"banned_ips,omitempty"`
+	// PauseOnBuffering mirrors RoomSettings.pause_on_buffering.
+	PauseOnBuffering bool  `json:"pause_on_buffering,omitempty"`
+	ControlMode      int32 `json:"control_mode,omitempty"`
+	// PremiereStartMs and PremiereMedia (a marshaled MediaInfo) hold the
+	// room's scheduled start, if any.
+	PremiereStartMs int64  `json:"premiere_start_ms,omitempty"`
+	PremiereMedia   []byte `json:"premiere_media,omitempty"`
+	// Playlist is the marshaled Playlist.
+	Playlist            []byte `json:"playlist,omitempty"`
+	MembersEditPlaylist bool   `json:"members_edit_playlist,omitempty"`
+	// Public, Title and Description are the room's directory listing.
+	Public      bool   `json:"public,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// LatestState is the marshaled HostState.
+	LatestState []byte    `json:"latest_state,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type MemberRecord struct {
+	ID       string    `json:"id"`
+	Identity string    `json:"identity"`
+	Name     string    `json:"name"`
+	JoinedAt time.Time `json:"joined_at"`
+	// ResumeToken is the only way back into the member's slot after a
+	// restart, so the store files are readable by the owner only.
+	ResumeToken string `json:"resume_token,omitempty"`
+}
+
+// MemoryStore keeps rooms in a map. Nothing survives a restart; it is the
+// default when no store directory is configured.
+type MemoryStore struct {
+	mu    sync.Mutex
+	rooms map[string]RoomRecord
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rooms: make(map[string]RoomRecord)}
+}
+
+func (m *MemoryStore) Load() ([]RoomRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return sortedRecords(m.rooms), nil
+}
+
+func (m *MemoryStore) Put(record RoomRecord) error {
+	m.mu.Lock()
+	m.rooms[record.ID] = record
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryStore) Delete(roomID string) error {
+	m.mu.Lock()
+	delete(m.rooms, roomID)
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryStore) Close() error { return nil }
+
+// FileStore keeps a JSON snapshot of all rooms plus an append-only log of
+// changes since that snapshot. Opening the store replays the log and
+// compacts it into a new snapshot.
+type FileStore struct {
+	dir string
+
+	mu      sync.Mutex
+	rooms   map[string]RoomRecord
+	logFile *os.File
+	entries int
+}
+
+type storeLogEntry struct {
+	Op     string      `json:"op"`
+	Room   *RoomRecord `json:"room,omitempty"`
+	RoomID string      `json:"room_id,omitempty"`
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("store dir is empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	f := &FileStore{dir: dir, rooms: make(map[string]RoomRecord)}
+	if err := f.readSnapshot(); err != nil {
+		return nil, err
+	}
+	if err := f.replayLog(); err != nil {
+		return nil, err
+	}
+	if err := f.compactLocked(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileStore) Load() ([]RoomRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return sortedRecords(f.rooms), nil
+}
+
+func (f *FileStore) Put(record RoomRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rooms[record.ID] = record
+	return f.appendLocked(storeLogEntry{Op: "put", Room: &record})
+}
+
+func (f *FileStore) Delete(roomID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.rooms[roomID]; !ok {
+		return nil
+	}
+	delete(f.rooms, roomID)
+	return f.appendLocked(storeLogEntry{Op: "delete", RoomID: roomID})
+}
+
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	err := f.compactLocked()
+	if f.logFile == nil {
+		return err
+	}
+	if closeErr := f.logFile.Close(); err == nil {
+		err = closeErr
+	}
+	f.logFile = nil
+	return err
+}
+
+func (f *FileStore) appendLocked(entry storeLogEntry) error {
+	if f.logFile == nil {
+		return errors.New("store closed")
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := f.logFile.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	f.entries++
+	if f.entries >= storeCompactEvery {
+		return f.compactLocked()
+	}
+	return nil
+}
+
+func (f *FileStore) readSnapshot() error {
+	data, err := os.ReadFile(filepath.Join(f.dir, storeSnapshotFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	var records []RoomRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("read room snapshot: %w", err)
+	}
+	for _, record := range records {
+		f.rooms[record.ID] = record
+	}
+	return nil
+}
+
+func (f *FileStore) replayLog() error {
+	file, err := os.Open(filepath.Join(f.dir, storeLogFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4<<20)
+	for scanner.Scan() {
+		var entry storeLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// A torn final line from a crash; everything before it is intact.
+			break
+		}
+		switch entry.Op {
+		case "put":
+			if entry.Room != nil {
+				f.rooms[entry.Room.ID] = *entry.Room
+			}
+		case "delete":
+			delete(f.rooms, entry.RoomID)
+		}
+	}
+	return scanner.Err()
+}
+
+// compactLocked writes the current rooms as the new snapshot and starts an
+// empty log.
+func (f *FileStore) compactLocked() error {
+	data, err := json.MarshalIndent(sortedRecords(f.rooms), "", "  ")
+	if err != nil {
+		return err
+	}
+	snapshotPath := filepath.Join(f.dir, storeSnapshotFile)
+	tmpPath := snapshotPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, snapshotPath); err != nil {
+		return err
+	}
+
+	logFile, err := os.OpenFile(filepath.Join(f.dir, storeLogFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		// Keep appending to the old log: replaying entries the snapshot
+		// already holds is harmless, and the next append retries.
+		return fmt.Errorf("open room log: %w", err)
+	}
+	if f.logFile != nil {
+		_ = f.logFile.Close()
+	}
+	f.logFile = logFile
+	f.entries = 0
+	return nil
+}
+
+func sortedRecords(rooms map[string]RoomRecord) []RoomRecord {
+	records := make([]RoomRecord, 0, len(rooms))
+	for _, record := range rooms {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].ID < records[j].ID })
+	return records
+}
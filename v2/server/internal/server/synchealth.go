@@ -0,0 +1,105 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	videowithyoupb "videowithyou/v2/proto/gen"
+)
+
+const (
+	syncHealthInterval = 2 * time.Second
+	// followerReportTTL drops reports from members that stopped sending,
+	// e.g. because their endpoint went inactive.
+	followerReportTTL = 15 * time.Second
+)
+
+type followerReport struct {
+	report     *videowithyoupb.FollowerReport
+	receivedAt time.Time
+}
+
+func (s *Server) handleFollowerReport(client *Client, report *videowithyoupb.FollowerReport) {
+	if report == nil {
+		return
+	}
+	s.mu.Lock()
+	room := s.rooms[client.roomID]
+	if room == nil || room.id != report.RoomId || room.hostID == client.id {
+		s.mu.Unlock()
+		return
+	}
+	report.MemberId = client.id
+	if room.reports == nil {
+		room.reports = make(map[string]*followerReport)
+	}
+	room.reports[client.id] = &followerReport{report: report, receivedAt: time.Now()}
+	room.reportsDirty = true
+	s.mu.Unlock()
+}
+
+// syncHealthLoop sends each host a summary of its followers' latest reports
+// whenever new reports arrived.
+func (s *Server) syncHealthLoop() {
+	ticker := time.NewTicker(syncHealthInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		type summary struct {
+			host   *Client
+			health *videowithyoupb.SyncHealth
+		}
+		now := time.Now()
+		summaries := make([]summary, 0)
+
+		s.mu.Lock()
+		for _, room := range s.rooms {
+			if !room.reportsDirty {
+				continue
+			}
+			room.reportsDirty = false
+			host := room.members[room.hostID]
+			if host == nil || !host.connected {
+				continue
+			}
+			summaries = append(summaries, summary{host: host, health: buildSyncHealthLocked(room, now)})
+		}
+		s.mu.Unlock()
+
+		for _, item := range summaries {
+			_ = s.sendEnvelope(item.host, &videowithyoupb.Envelope{
+				Payload: &videowithyoupb.Envelope_SyncHealth{SyncHealth: item.health},
+			})
+		}
+	}
+}
+
+// buildSyncHealthLocked summarizes the fresh reports of room's current
+// followers. It is called with s.mu held and prunes stale reports.
+func buildSyncHealthLocked(room *Room, now time.Time) *videowithyoupb.SyncHealth {
+	health := &videowithyoupb.SyncHealth{
+		RoomId:       room.id,
+		ServerTimeMs: now.UnixMilli(),
+	}
+	for id, entry := range room.reports {
+		member := room.members[id]
+		if member == nil || id == room.hostID || now.Sub(entry.receivedAt) > followerReportTTL {
+			delete(room.reports, id)
+			continue
+		}
+		report := entry.report
+		health.Members = append(health.Members, &videowithyoupb.MemberSyncHealth{
+			MemberId:               id,
+			DisplayName:            member.name,
+			DriftMs:                report.DriftMs,
+			PositionMs:             report.PositionMs,
+			Rate:                   report.Rate,
+			Endpoint:               report.Endpoint,
+			LastAction:             report.LastAction,
+			LastActionServerTimeMs: report.LastActionServerTimeMs,
+			ReportAgeMs:            now.Sub(entry.receivedAt).Milliseconds(),
+		})
+	}
+	sort.Slice(health.Members, func(i, j int) bool { return health.Members[i].MemberId < health.Members[j].MemberId })
+	return health
+}